@@ -0,0 +1,267 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/haysons/norm/clause"
+	"github.com/haysons/norm/statement"
+)
+
+const defaultBulkBatchSize = 500
+
+// BulkOption is the option of BulkInsertVertex and BulkInsertEdge
+type BulkOption func(opts *bulkOptions)
+
+type bulkOptions struct {
+	batchSize   int
+	batchBytes  int
+	workers     int
+	ifNotExists bool
+	progress    func(progress BulkProgress)
+}
+
+// WithBatchSize sets the max number of rows inserted by one statement, 500 by default
+func WithBatchSize(size int) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.batchSize = size
+	}
+}
+
+// WithBatchBytes sets the max length in bytes of the statement of a batch, it works together with WithBatchSize, a
+// batch is cut as soon as either limit is reached. The statement is measured with the values inlined, so it is an
+// upper bound of the parameterized statement. A row that exceeds the budget by itself is inserted in a batch of its
+// own.
+func WithBatchBytes(bytes int) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.batchBytes = bytes
+	}
+}
+
+// WithWorkers sets the number of batches inserted concurrently, 1 by default. The statements are executed through the
+// session pool, so the workers beyond the max size of the pool wait for an idle session.
+func WithWorkers(workers int) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.workers = workers
+	}
+}
+
+// WithIfNotExists inserts the rows with IF NOT EXISTS, which makes the batches retryable by the retry policy
+func WithIfNotExists() BulkOption {
+	return func(opts *bulkOptions) {
+		opts.ifNotExists = true
+	}
+}
+
+// WithProgress sets the callback called after each batch is done, whether successful or not. The calls are
+// serialized, so the callback needs no synchronization, but it should return quickly as it blocks the workers.
+func WithProgress(fn func(progress BulkProgress)) BulkOption {
+	return func(opts *bulkOptions) {
+		opts.progress = fn
+	}
+}
+
+// BulkProgress is the progress of a bulk insert, the counts are in rows
+type BulkProgress struct {
+	Total     int
+	Succeeded int
+	Failed    int
+}
+
+// BulkResult is the report of a bulk insert, the failed batches are ordered by the index of their first row
+type BulkResult struct {
+	Total     int
+	Succeeded int
+	Batches   int
+	Failed    []*BatchError
+}
+
+// Err joins the errors of the failed batches, nil is returned if all the batches succeeded
+func (r *BulkResult) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Failed))
+	for _, failed := range r.Failed {
+		errs = append(errs, failed)
+	}
+	return errors.Join(errs...)
+}
+
+// BatchError is the error of a failed batch, which contains the rows in [Start, End) of the given slice
+type BatchError struct {
+	Start int
+	End   int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("norm: insert rows [%d, %d) failed, %v", e.Start, e.End, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// BulkInsertVertex splits the slice of vertices into batches and inserts them with INSERT VERTEX, the batches are
+// bounded by WithBatchSize and WithBatchBytes and inserted by WithWorkers workers concurrently. A failed batch does not
+// stop the others, the failed rows are reported in the result, and the returned error joins the errors of the failed
+// batches. Once the context of db is done, the batches not yet started are reported as failed with ctx.Err().
+//
+//	res, err := db.BulkInsertVertex(players, norm.WithBatchSize(1000), norm.WithWorkers(4))
+//	if err != nil {
+//		for _, failed := range res.Failed {
+//			retry(players[failed.Start:failed.End])
+//		}
+//	}
+func (db *DB) BulkInsertVertex(vertexes any, opts ...BulkOption) (*BulkResult, error) {
+	return db.bulkInsert(vertexes, opts, insertVertexRows, func(rows reflect.Value) ([]int, error) {
+		return clause.InsertVertex{Vertexes: rows}.ValueLens()
+	})
+}
+
+// BulkInsertEdge splits the slice of edges into batches and inserts them with INSERT EDGE, see BulkInsertVertex
+func (db *DB) BulkInsertEdge(edges any, opts ...BulkOption) (*BulkResult, error) {
+	return db.bulkInsert(edges, opts, insertEdgeRows, func(rows reflect.Value) ([]int, error) {
+		return clause.InsertEdge{Edges: rows}.ValueLens()
+	})
+}
+
+// BulkInsertVertex is the generic version of DB.BulkInsertVertex, the vertices are inserted with the given context
+func BulkInsertVertex[T any](ctx context.Context, db *DB, vertexes []T, opts ...BulkOption) (*BulkResult, error) {
+	return db.withContext(ctx).BulkInsertVertex(vertexes, opts...)
+}
+
+// BulkInsertEdge is the generic version of DB.BulkInsertEdge, the edges are inserted with the given context
+func BulkInsertEdge[T any](ctx context.Context, db *DB, edges []T, opts ...BulkOption) (*BulkResult, error) {
+	return db.withContext(ctx).BulkInsertEdge(edges, opts...)
+}
+
+// bulkInsertFunc adds the insert clause of the rows to the statement
+type bulkInsertFunc func(stmt *statement.Statement, rows any, ifNotExists bool)
+
+// bulkValueLensFunc returns the length of the value of each row in the VALUES of the insert statement
+type bulkValueLensFunc func(rows reflect.Value) ([]int, error)
+
+func insertVertexRows(stmt *statement.Statement, rows any, ifNotExists bool) {
+	stmt.InsertVertex(rows, ifNotExists)
+}
+
+func insertEdgeRows(stmt *statement.Statement, rows any, ifNotExists bool) {
+	stmt.InsertEdge(rows, ifNotExists)
+}
+
+type bulkBatch struct {
+	start int
+	end   int
+}
+
+func (db *DB) bulkInsert(rows any, opts []BulkOption, insert bulkInsertFunc, valueLens bulkValueLensFunc) (*BulkResult, error) {
+	bulkOpts := &bulkOptions{batchSize: defaultBulkBatchSize, workers: 1}
+	for _, opt := range opts {
+		opt(bulkOpts)
+	}
+	if bulkOpts.batchSize <= 0 || bulkOpts.workers <= 0 || bulkOpts.batchBytes < 0 {
+		return nil, fmt.Errorf("norm: %w, batch size and workers must be positive", ErrInvalidValue)
+	}
+	rowsValue := reflect.Indirect(reflect.ValueOf(rows))
+	if rowsValue.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("norm: %w, bulk insert expects a slice, got %T", ErrInvalidValue, rows)
+	}
+
+	batches, err := splitBatches(rowsValue, bulkOpts, insert, valueLens)
+	if err != nil {
+		return nil, err
+	}
+	res := &BulkResult{Total: rowsValue.Len(), Batches: len(batches)}
+	ctx := db.Context()
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		failed   int
+		batchErr = make([]error, len(batches))
+		indexes  = make(chan int)
+	)
+	done := func(batch bulkBatch, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failed += batch.end - batch.start
+		} else {
+			res.Succeeded += batch.end - batch.start
+		}
+		if bulkOpts.progress != nil {
+			bulkOpts.progress(BulkProgress{Total: res.Total, Succeeded: res.Succeeded, Failed: failed})
+		}
+	}
+	for i := 0; i < bulkOpts.workers && i < len(batches); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				batch := batches[index]
+				err := ctx.Err()
+				if err == nil {
+					tx := db.session()
+					insert(tx.Statement, rowsValue.Slice(batch.start, batch.end).Interface(), bulkOpts.ifNotExists)
+					err = tx.Exec()
+				}
+				batchErr[index] = err
+				done(batch, err)
+			}
+		}()
+	}
+	for index := range batches {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	for index, err := range batchErr {
+		if err != nil {
+			res.Failed = append(res.Failed, &BatchError{Start: batches[index].start, End: batches[index].end, Err: err})
+		}
+	}
+	return res, res.Err()
+}
+
+// splitBatches splits the rows into batches by the batch size, and by the byte budget if it is set. The statement of a
+// batch is measured as the INSERT header, which is built once, followed by the values of its rows separated by commas.
+func splitBatches(rows reflect.Value, opts *bulkOptions, insert bulkInsertFunc, valueLens bulkValueLensFunc) ([]bulkBatch, error) {
+	batches := make([]bulkBatch, 0, rows.Len()/opts.batchSize+1)
+	if rows.Len() == 0 {
+		return batches, nil
+	}
+	headerBytes := 0
+	var valueBytes []int
+	if opts.batchBytes > 0 {
+		stmt := statement.New()
+		insert(stmt, rows.Slice(0, 0).Interface(), opts.ifNotExists)
+		header, err := stmt.NGQL()
+		if err != nil {
+			return nil, fmt.Errorf("norm: build insert header failed, %w", err)
+		}
+		headerBytes = len(header)
+		if valueBytes, err = valueLens(rows); err != nil {
+			return nil, fmt.Errorf("norm: measure rows failed, %w", err)
+		}
+	}
+	start, bytes := 0, headerBytes
+	for i := 0; i < rows.Len(); i++ {
+		if i > start && (i-start >= opts.batchSize || (opts.batchBytes > 0 && bytes+len(", ")+valueBytes[i] > opts.batchBytes)) {
+			batches = append(batches, bulkBatch{start: start, end: i})
+			start, bytes = i, headerBytes
+		}
+		if opts.batchBytes > 0 {
+			if i > start {
+				bytes += len(", ")
+			}
+			bytes += valueBytes[i]
+		}
+	}
+	batches = append(batches, bulkBatch{start: start, end: rows.Len()})
+	return batches, nil
+}
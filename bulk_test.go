@@ -0,0 +1,115 @@
+package norm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+	"github.com/haysons/norm/statement"
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+func TestSplitBatches(t *testing.T) {
+	players := make([]retryPlayer, 5)
+	for i := range players {
+		players[i] = retryPlayer{VID: fmt.Sprintf("player10%d", i), Name: "Tim"}
+	}
+	headerNGQL, err := statement.New().InsertVertex(players[:0]).NGQL()
+	if !assert.NoError(t, err) {
+		return
+	}
+	rowNGQL, err := statement.New().InsertVertex(players[:1]).NGQL()
+	if !assert.NoError(t, err) {
+		return
+	}
+	header, value := len(headerNGQL), len(rowNGQL)-len(headerNGQL)
+	// the statement of n rows is the header followed by n values separated by commas
+	batchBytes := func(n int) int {
+		return header + n*value + (n-1)*len(", ")
+	}
+	tests := []struct {
+		rows []retryPlayer
+		opts bulkOptions
+		want []bulkBatch
+	}{
+		{rows: players, opts: bulkOptions{batchSize: 500}, want: []bulkBatch{{0, 5}}},
+		{rows: players, opts: bulkOptions{batchSize: 2}, want: []bulkBatch{{0, 2}, {2, 4}, {4, 5}}},
+		{rows: players, opts: bulkOptions{batchSize: 1}, want: []bulkBatch{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{rows: players, opts: bulkOptions{batchSize: 500, batchBytes: batchBytes(3)}, want: []bulkBatch{{0, 3}, {3, 5}}},
+		{rows: players, opts: bulkOptions{batchSize: 500, batchBytes: batchBytes(3) - 1}, want: []bulkBatch{{0, 2}, {2, 4}, {4, 5}}},
+		{rows: players, opts: bulkOptions{batchSize: 2, batchBytes: batchBytes(3)}, want: []bulkBatch{{0, 2}, {2, 4}, {4, 5}}},
+		{rows: players[:3], opts: bulkOptions{batchSize: 500, batchBytes: batchBytes(1) - 1}, want: []bulkBatch{{0, 1}, {1, 2}, {2, 3}}},
+		{rows: players[:0], opts: bulkOptions{batchSize: 500, batchBytes: batchBytes(1)}, want: []bulkBatch{}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			batches, err := splitBatches(reflect.ValueOf(tt.rows), &tt.opts, insertVertexRows, func(rows reflect.Value) ([]int, error) {
+				return clause.InsertVertex{Vertexes: rows}.ValueLens()
+			})
+			if !assert.NoError(t, err) || !assert.Equal(t, tt.want, batches) {
+				return
+			}
+			if tt.opts.batchBytes == 0 {
+				return
+			}
+			for _, batch := range batches {
+				nGQL, err := statement.New().InsertVertex(tt.rows[batch.start:batch.end]).NGQL()
+				if assert.NoError(t, err) {
+					assert.Equal(t, batchBytes(batch.end-batch.start), len(nGQL))
+				}
+			}
+		})
+	}
+}
+
+func TestBulkInsertInvalid(t *testing.T) {
+	players := []retryPlayer{{VID: "player100", Name: "Tim"}}
+	tests := []struct {
+		rows any
+		opts []BulkOption
+	}{
+		{rows: retryPlayer{VID: "player100"}},
+		{rows: [1]retryPlayer{{VID: "player100"}}},
+		{rows: map[string]retryPlayer{}},
+		{rows: nil},
+		{rows: players, opts: []BulkOption{WithBatchSize(0)}},
+		{rows: players, opts: []BulkOption{WithBatchSize(-1)}},
+		{rows: players, opts: []BulkOption{WithWorkers(0)}},
+		{rows: players, opts: []BulkOption{WithBatchBytes(-1)}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			res, err := (&DB{}).BulkInsertVertex(tt.rows, tt.opts...)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrInvalidValue)
+		})
+	}
+}
+
+func TestBulkInsertEmpty(t *testing.T) {
+	res, err := (&DB{}).BulkInsertVertex([]retryPlayer{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, &BulkResult{}, res)
+	}
+}
+
+func TestBulkInsertContext(t *testing.T) {
+	db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+		t.Fatalf("unexpected statement %s", nGQL)
+		return nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	players := []retryPlayer{{VID: "player100"}, {VID: "player101"}, {VID: "player102"}}
+	res, err := BulkInsertVertex(ctx, db, players, WithBatchSize(2))
+	assert.ErrorIs(t, err, context.Canceled)
+	if assert.NotNil(t, res) {
+		assert.Equal(t, 0, res.Succeeded)
+		assert.Equal(t, 2, res.Batches)
+		assert.Len(t, res.Failed, 2)
+	}
+	assert.Nil(t, db.ctx)
+}
@@ -0,0 +1,339 @@
+package norm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/haysons/norm/statement"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+// names of the built-in callbacks, which are registered in every processor
+const (
+	// CallbackBuild builds the statement, callbacks registered before it can still change the statement and its parts
+	CallbackBuild = "norm:build"
+	// CallbackExecute executes the built statement, callbacks registered after it can read the result set
+	CallbackExecute = "norm:execute"
+)
+
+// CallbackFunc is called with the DB that is executing the statement. The statement can be read and changed through
+// db.Statement, the built nGQL can be obtained by db.Statement.NGQL once the statement has been built, and the result
+// set can be obtained by db.ResultSet once the statement has been executed. Returning an error aborts the execution,
+// the remaining callbacks are skipped and the error is returned to the caller.
+type CallbackFunc func(db *DB) error
+
+// Callbacks is the registry of the callbacks of a DB, there is a processor for each kind of operation
+type Callbacks struct {
+	processors map[OperationKind]*CallbackProcessor
+}
+
+// OperationKind is the kind of operation a statement performs, which decides the processor used to execute it
+type OperationKind string
+
+const (
+	OperationQuery   OperationKind = "query"
+	OperationInsert  OperationKind = "insert"
+	OperationUpdate  OperationKind = "update"
+	OperationDelete  OperationKind = "delete"
+	OperationMigrate OperationKind = "migrate"
+)
+
+func newCallbacks() *Callbacks {
+	cbs := &Callbacks{processors: make(map[OperationKind]*CallbackProcessor)}
+	for _, kind := range []OperationKind{OperationQuery, OperationInsert, OperationUpdate, OperationDelete, OperationMigrate} {
+		cbs.processors[kind] = &CallbackProcessor{
+			callbacks: []namedCallback{
+				{name: CallbackBuild, fn: buildCallback},
+				{name: CallbackExecute, fn: executeCallback},
+			},
+		}
+	}
+	return cbs
+}
+
+// Query returns the processor of the query statements, such as GO, FETCH, LOOKUP and MATCH. The statements written by
+// Raw are dispatched by their leading keywords, so that a raw INSERT, UPDATE, UPSERT or DELETE goes through the Insert,
+// Update or Delete processor and a raw CREATE, ALTER, DROP or REBUILD through the Migrate processor, the raw
+// statements with any other keyword, such as USE, go through this processor.
+func (cbs *Callbacks) Query() *CallbackProcessor {
+	return cbs.processors[OperationQuery]
+}
+
+// Insert returns the processor of the INSERT VERTEX and INSERT EDGE statements
+func (cbs *Callbacks) Insert() *CallbackProcessor {
+	return cbs.processors[OperationInsert]
+}
+
+// Update returns the processor of the UPDATE and UPSERT statements
+func (cbs *Callbacks) Update() *CallbackProcessor {
+	return cbs.processors[OperationUpdate]
+}
+
+// Delete returns the processor of the DELETE VERTEX and DELETE EDGE statements
+func (cbs *Callbacks) Delete() *CallbackProcessor {
+	return cbs.processors[OperationDelete]
+}
+
+// Migrate returns the processor of the statements that create, alter, drop or rebuild the schema
+func (cbs *Callbacks) Migrate() *CallbackProcessor {
+	return cbs.processors[OperationMigrate]
+}
+
+// CallbackProcessor holds the ordered callbacks of one kind of operation, it is concurrency-safe
+type CallbackProcessor struct {
+	mu        sync.RWMutex
+	callbacks []namedCallback
+}
+
+type namedCallback struct {
+	name string
+	fn   CallbackFunc
+}
+
+// Register appends the callback to the end of the processor
+func (p *CallbackProcessor) Register(name string, fn CallbackFunc) error {
+	return p.register(name, fn, "", "")
+}
+
+// Before specifies that the next registered callback runs before the callback with the given name
+//
+// db.Callback().Query().Before(norm.CallbackBuild).Register("tenant", addTenantCondition)
+func (p *CallbackProcessor) Before(name string) *CallbackRegistration {
+	return &CallbackRegistration{processor: p, before: name}
+}
+
+// After specifies that the next registered callback runs after the callback with the given name
+//
+// db.Callback().Insert().After(norm.CallbackExecute).Register("audit", audit)
+func (p *CallbackProcessor) After(name string) *CallbackRegistration {
+	return &CallbackRegistration{processor: p, after: name}
+}
+
+// Replace replaces the callback with the given name, keeping its position
+func (p *CallbackProcessor) Replace(name string, fn CallbackFunc) error {
+	if fn == nil {
+		return fmt.Errorf("norm: %w, callback %s is nil", ErrInvalidValue, name)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	idx := p.index(name)
+	if idx < 0 {
+		return fmt.Errorf("norm: callback %s not found", name)
+	}
+	callbacks := append([]namedCallback(nil), p.callbacks...)
+	callbacks[idx].fn = fn
+	p.callbacks = callbacks
+	return nil
+}
+
+// Remove removes the callback with the given name
+func (p *CallbackProcessor) Remove(name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	idx := p.index(name)
+	if idx < 0 {
+		return fmt.Errorf("norm: callback %s not found", name)
+	}
+	callbacks := make([]namedCallback, 0, len(p.callbacks)-1)
+	callbacks = append(callbacks, p.callbacks[:idx]...)
+	callbacks = append(callbacks, p.callbacks[idx+1:]...)
+	p.callbacks = callbacks
+	return nil
+}
+
+// Get returns the callback with the given name, nil is returned if it does not exist
+func (p *CallbackProcessor) Get(name string) CallbackFunc {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if idx := p.index(name); idx >= 0 {
+		return p.callbacks[idx].fn
+	}
+	return nil
+}
+
+// Names returns the names of the callbacks in the order they are called
+func (p *CallbackProcessor) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	names := make([]string, 0, len(p.callbacks))
+	for _, cb := range p.callbacks {
+		names = append(names, cb.name)
+	}
+	return names
+}
+
+func (p *CallbackProcessor) register(name string, fn CallbackFunc, before, after string) error {
+	if fn == nil {
+		return fmt.Errorf("norm: %w, callback %s is nil", ErrInvalidValue, name)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.index(name) >= 0 {
+		return fmt.Errorf("norm: callback %s already registered", name)
+	}
+	idx := len(p.callbacks)
+	if before != "" {
+		if idx = p.index(before); idx < 0 {
+			return fmt.Errorf("norm: callback %s not found", before)
+		}
+	}
+	if after != "" {
+		if idx = p.index(after); idx < 0 {
+			return fmt.Errorf("norm: callback %s not found", after)
+		}
+		idx++
+	}
+	// the slice is never modified in place, so that the executing statements can keep using the old one
+	callbacks := make([]namedCallback, 0, len(p.callbacks)+1)
+	callbacks = append(callbacks, p.callbacks[:idx]...)
+	callbacks = append(callbacks, namedCallback{name: name, fn: fn})
+	callbacks = append(callbacks, p.callbacks[idx:]...)
+	p.callbacks = callbacks
+	return nil
+}
+
+func (p *CallbackProcessor) index(name string) int {
+	for i, cb := range p.callbacks {
+		if cb.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// execute calls the callbacks in turn and returns the result set of the statement
+func (p *CallbackProcessor) execute(db *DB) (*nebula.ResultSet, error) {
+	p.mu.RLock()
+	callbacks := p.callbacks
+	p.mu.RUnlock()
+	for _, cb := range callbacks {
+		if err := cb.fn(db); err != nil {
+			return nil, err
+		}
+	}
+	if db.resultSet == nil {
+		return nil, fmt.Errorf("norm: the statement was not executed, check the %s callback", CallbackExecute)
+	}
+	return db.resultSet, nil
+}
+
+// CallbackRegistration registers a callback at a position relative to another callback
+type CallbackRegistration struct {
+	processor *CallbackProcessor
+	before    string
+	after     string
+}
+
+// Register registers the callback at the specified position
+func (r *CallbackRegistration) Register(name string, fn CallbackFunc) error {
+	return r.processor.register(name, fn, r.before, r.after)
+}
+
+func buildCallback(db *DB) error {
+	return db.Statement.Build()
+}
+
+func executeCallback(db *DB) error {
+	nGQL, params, err := db.NGQLWithParams()
+	if err != nil {
+		return err
+	}
+	policy := db.conf.retryPolicy
+	maxAttempts := 1
+	if policy != nil && db.retryable() {
+		maxAttempts = policy.MaxAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		db.resultSet, err = db.execute(nGQL, params)
+		if attempt >= maxAttempts || !policy.shouldRetry(err, db.resultSet) {
+			return err
+		}
+		if err = sleepContext(db.Context(), policy.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// Operation returns the kind of operation the statement of the DB performs
+func (db *DB) Operation() OperationKind {
+	return operationKind(db.Statement)
+}
+
+// operationKind determines the kind of operation from the types of the parts of the statement, the statement writes
+// data or changes the schema if any of its parts does, the raw statements are classified by their leading keywords
+func operationKind(stmt *statement.Statement) OperationKind {
+	if raw := stmt.RawNGQL(); raw != "" {
+		kind, _ := rawOperationKind(raw)
+		return kind
+	}
+	for _, part := range stmt.Parts() {
+		if kind := partOperationKind(part.GetType()); kind != OperationQuery {
+			return kind
+		}
+	}
+	return OperationQuery
+}
+
+// partOperationKind returns the kind of operation performed by the part of the given type
+func partOperationKind(typ statement.PartType) OperationKind {
+	switch typ {
+	case statement.PartTypeInsertVertex, statement.PartTypeInsertEdge:
+		return OperationInsert
+	case statement.PartTypeUpdateVertex, statement.PartTypeUpdateEdge:
+		return OperationUpdate
+	case statement.PartTypeDeleteVertex, statement.PartTypeDeleteEdge:
+		return OperationDelete
+	case statement.PartTypeCreateTag, statement.PartTypeDropTag, statement.PartTypeAlterTag,
+		statement.PartTypeCreateEdge, statement.PartTypeDropEdge, statement.PartTypeAlterEdge,
+		statement.PartTypeCreateIndex, statement.PartTypeRebuildIndex, statement.PartTypeDropIndex,
+		statement.PartTypeCreateSpace, statement.PartTypeDropSpace:
+		return OperationMigrate
+	default:
+		return OperationQuery
+	}
+}
+
+// the leading keywords of the raw statements that only read data
+var rawQueryKeywords = map[string]bool{
+	"GO": true, "FETCH": true, "LOOKUP": true, "MATCH": true, "OPTIONAL": true, "UNWIND": true, "WITH": true,
+	"RETURN": true, "FIND": true, "GET": true, "SHOW": true, "DESCRIBE": true, "DESC": true, "YIELD": true,
+}
+
+// the leading keywords of the raw statements that write data or change the schema
+var rawWriteKeywords = map[string]OperationKind{
+	"INSERT": OperationInsert, "UPDATE": OperationUpdate, "UPSERT": OperationUpdate, "DELETE": OperationDelete,
+	"CREATE": OperationMigrate, "ALTER": OperationMigrate, "DROP": OperationMigrate, "REBUILD": OperationMigrate,
+}
+
+// rawOperationKind classifies the raw nGQL by the leading keyword of each of its statements, the kind of the first
+// write is returned, and query reports whether every statement is known to only read data. The statements with unknown
+// keywords, such as USE, are executed by the query processor but are not regarded as queries.
+func rawOperationKind(raw string) (kind OperationKind, query bool) {
+	kind, query = OperationQuery, true
+	for _, s := range strings.Split(raw, ";") {
+		s = strings.TrimSpace(s)
+		// the result of the statement may be assigned to a variable, $var = GO FROM ...
+		if strings.HasPrefix(s, "$") {
+			if i := strings.Index(s, "="); i >= 0 {
+				s = strings.TrimSpace(s[i+1:])
+			}
+		}
+		fields := strings.Fields(strings.TrimLeft(s, "("))
+		if len(fields) == 0 {
+			continue
+		}
+		keyword := strings.ToUpper(fields[0])
+		if writeKind, ok := rawWriteKeywords[keyword]; ok {
+			if kind == OperationQuery {
+				kind = writeKind
+			}
+			query = false
+			continue
+		}
+		if !rawQueryKeywords[keyword] {
+			query = false
+		}
+	}
+	return kind, query
+}
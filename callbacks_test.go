@@ -0,0 +1,122 @@
+package norm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallbackProcessor(t *testing.T) {
+	noop := func(db *DB) error { return nil }
+	tests := []struct {
+		setup   func(p *CallbackProcessor) error
+		want    []string
+		wantErr bool
+	}{
+		{
+			setup: func(p *CallbackProcessor) error { return nil },
+			want:  []string{CallbackBuild, CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.Register("audit", noop) },
+			want:  []string{CallbackBuild, CallbackExecute, "audit"},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.Before(CallbackBuild).Register("tenant", noop) },
+			want:  []string{"tenant", CallbackBuild, CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.Before(CallbackExecute).Register("guard", noop) },
+			want:  []string{CallbackBuild, "guard", CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.After(CallbackBuild).Register("trace", noop) },
+			want:  []string{CallbackBuild, "trace", CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.After(CallbackExecute).Register("audit", noop) },
+			want:  []string{CallbackBuild, CallbackExecute, "audit"},
+		},
+		{
+			setup: func(p *CallbackProcessor) error {
+				if err := p.After(CallbackBuild).Register("a", noop); err != nil {
+					return err
+				}
+				return p.After(CallbackBuild).Register("b", noop)
+			},
+			want: []string{CallbackBuild, "b", "a", CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.Replace(CallbackBuild, noop) },
+			want:  []string{CallbackBuild, CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error { return p.Remove(CallbackBuild) },
+			want:  []string{CallbackExecute},
+		},
+		{
+			setup: func(p *CallbackProcessor) error {
+				if err := p.Register("audit", noop); err != nil {
+					return err
+				}
+				return p.Remove(CallbackExecute)
+			},
+			want: []string{CallbackBuild, "audit"},
+		},
+		{
+			setup:   func(p *CallbackProcessor) error { return p.Register(CallbackBuild, noop) },
+			want:    []string{CallbackBuild, CallbackExecute},
+			wantErr: true,
+		},
+		{
+			setup:   func(p *CallbackProcessor) error { return p.Register("audit", nil) },
+			want:    []string{CallbackBuild, CallbackExecute},
+			wantErr: true,
+		},
+		{
+			setup:   func(p *CallbackProcessor) error { return p.Before("missing").Register("audit", noop) },
+			want:    []string{CallbackBuild, CallbackExecute},
+			wantErr: true,
+		},
+		{
+			setup:   func(p *CallbackProcessor) error { return p.After("missing").Register("audit", noop) },
+			want:    []string{CallbackBuild, CallbackExecute},
+			wantErr: true,
+		},
+		{
+			setup:   func(p *CallbackProcessor) error { return p.Replace("missing", noop) },
+			want:    []string{CallbackBuild, CallbackExecute},
+			wantErr: true,
+		},
+		{
+			setup:   func(p *CallbackProcessor) error { return p.Remove("missing") },
+			want:    []string{CallbackBuild, CallbackExecute},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			p := newCallbacks().Query()
+			err := tt.setup(p)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, p.Names())
+		})
+	}
+}
+
+func TestCallbackReplace(t *testing.T) {
+	p := newCallbacks().Query()
+	called := false
+	assert.NoError(t, p.Replace(CallbackExecute, func(db *DB) error {
+		called = true
+		return nil
+	}))
+	assert.NoError(t, p.Get(CallbackExecute)(nil))
+	assert.True(t, called)
+	assert.Nil(t, p.Get("missing"))
+}
@@ -44,8 +44,8 @@ func (ae AlterEdge) Build(nGQL Builder) error {
 	if len(ttlCols) > 1 {
 		return fmt.Errorf("norm: %w, build alter edge clause failed, must only one ttl col", ErrInvalidClauseParams)
 	}
-	if len(addProps) == 0 && len(ae.DropProps) == 0 && len(changeProps) == 0 && !ae.UpdateTTL {
+	if len(addProps) == 0 && len(ae.DropProps) == 0 && len(changeProps) == 0 && !ae.UpdateTTL && !ae.DropTTL {
 		return fmt.Errorf("norm: %w, build alter edge clause failed, must has operate", ErrInvalidClauseParams)
 	}
-	return buildAlterProps(addProps, changeProps, ae.DropProps, ae.UpdateTTL, ttlCols, ttlDuration, nGQL)
+	return buildAlterProps(addProps, changeProps, ae.DropProps, ae.UpdateTTL, ae.DropTTL, ttlCols, ttlDuration, nGQL)
 }
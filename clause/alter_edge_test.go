@@ -103,6 +103,21 @@ func TestAlterEdge(t *testing.T) {
 			},
 			gqlWant: `ALTER EDGE e1 DROP (p3, p4)`,
 		},
+		{
+			clauses: []clause.Interface{
+				func() clause.AlterEdge {
+					edge := &resolver.EdgeSchema{}
+					edge.SetTypeName("e1")
+					return clause.AlterEdge{
+						Edge: edge,
+						AlterOperate: clause.AlterOperate{
+							DropTTL: true,
+						},
+					}
+				}(),
+			},
+			gqlWant: `ALTER EDGE e1 TTL_COL = ""`,
+		},
 		{
 			clauses: []clause.Interface{
 				func() clause.AlterEdge {
@@ -18,6 +18,8 @@ type AlterOperate struct {
 	DropProps   []string
 	ChangeProps []string
 	UpdateTTL   bool
+	// DropTTL removes the ttl of the schema, it is ignored if UpdateTTL sets a new one
+	DropTTL bool
 }
 
 const AlterTagName = "ALTER_TAG"
@@ -52,13 +54,13 @@ func (at AlterTag) Build(nGQL Builder) error {
 	if len(ttlCols) > 1 {
 		return fmt.Errorf("norm: %w, build alter tag clause failed, must only one ttl col", ErrInvalidClauseParams)
 	}
-	if len(addProps) == 0 && len(at.DropProps) == 0 && len(changeProps) == 0 && !at.UpdateTTL {
+	if len(addProps) == 0 && len(at.DropProps) == 0 && len(changeProps) == 0 && !at.UpdateTTL && !at.DropTTL {
 		return fmt.Errorf("norm: %w, build alter tag clause failed, must has operate", ErrInvalidClauseParams)
 	}
-	return buildAlterProps(addProps, changeProps, at.DropProps, at.UpdateTTL, ttlCols, ttlDuration, nGQL)
+	return buildAlterProps(addProps, changeProps, at.DropProps, at.UpdateTTL, at.DropTTL, ttlCols, ttlDuration, nGQL)
 }
 
-func buildAlterProps(addProps, changeProps []*resolver.Prop, dropProps []string, updateTTL bool, ttlCols []string, ttlDuration string, nGQL Builder) error {
+func buildAlterProps(addProps, changeProps []*resolver.Prop, dropProps []string, updateTTL, dropTTL bool, ttlCols []string, ttlDuration string, nGQL Builder) error {
 	if len(addProps) > 0 {
 		nGQL.WriteString(" ADD ")
 		_, _, err := buildProps(addProps, nGQL)
@@ -97,6 +99,8 @@ func buildAlterProps(addProps, changeProps []*resolver.Prop, dropProps []string,
 		nGQL.WriteString(ttlDuration)
 		nGQL.WriteString(", TTL_COL = ")
 		nGQL.WriteString(strconv.Quote(ttlCols[0]))
+	} else if dropTTL {
+		nGQL.WriteString(` TTL_COL = ""`)
 	}
 	return nil
 }
@@ -98,6 +98,21 @@ func TestAlterTag(t *testing.T) {
 			},
 			gqlWant: `ALTER TAG t1 DROP (p3, p4)`,
 		},
+		{
+			clauses: []clause.Interface{
+				func() clause.AlterTag {
+					tag := &resolver.VertexTag{TagName: "t1"}
+					return clause.AlterTag{
+						Tag: tag,
+						AlterOperate: clause.AlterOperate{
+							DropProps: []string{"p2"},
+							DropTTL:   true,
+						},
+					}
+				}(),
+			},
+			gqlWant: `ALTER TAG t1 DROP (p2) TTL_COL = ""`,
+		},
 		{
 			clauses: []clause.Interface{
 				func() clause.AlterTag {
@@ -41,5 +41,12 @@ func (ce CreateEdge) Build(nGQL Builder) error {
 		nGQL.WriteString(", TTL_COL = ")
 		nGQL.WriteString(strconv.Quote(ttlCols[0]))
 	}
+	if comment := ce.Edge.GetComment(); comment != "" {
+		if len(ttlCols) == 1 && ttlDuration != "" {
+			nGQL.WriteByte(',')
+		}
+		nGQL.WriteString(" COMMENT = ")
+		nGQL.WriteString(strconv.Quote(comment))
+	}
 	return nil
 }
@@ -76,6 +76,24 @@ func TestCreateEdge(t *testing.T) {
 			},
 			gqlWant: `CREATE EDGE IF NOT EXISTS e1(p1 string, p2 int, p3 timestamp) TTL_DURATION = 100, TTL_COL = "p2"`,
 		},
+		{
+			clauses: []clause.Interface{
+				func() clause.CreateEdge {
+					edge := &resolver.EdgeSchema{}
+					edge.SetTypeName("e1")
+					edge.SetComment("the first edge")
+					edge.SetProps(
+						&resolver.Prop{Name: "p1", DataType: "string"},
+						&resolver.Prop{Name: "p2", DataType: "int", TTL: "100"},
+					)
+					return clause.CreateEdge{
+						IfNotExists: true,
+						Edge:        edge,
+					}
+				}(),
+			},
+			gqlWant: `CREATE EDGE IF NOT EXISTS e1(p1 string, p2 int) TTL_DURATION = 100, TTL_COL = "p2", COMMENT = "the first edge"`,
+		},
 		{
 			clauses: []clause.Interface{
 				func() clause.CreateEdge {
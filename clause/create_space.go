@@ -0,0 +1,97 @@
+package clause
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CreateSpace creates a graph space. If AsSpace is set, the schema of that space is cloned instead, in which case the
+// options of the space such as VIDType are ignored.
+type CreateSpace struct {
+	IfNotExists   bool
+	SpaceName     string
+	PartitionNum  int    // the default value of nebula graph is used if it is zero
+	ReplicaFactor int    // the default value of nebula graph is used if it is zero
+	VIDType       string // FIXED_STRING(<N>) or INT64
+	Comment       string
+	AsSpace       string
+}
+
+const CreateSpaceName = "CREATE_SPACE"
+
+func (cs CreateSpace) Name() string {
+	return CreateSpaceName
+}
+
+func (cs CreateSpace) MergeIn(clause *Clause) {
+	clause.Expression = cs
+}
+
+func (cs CreateSpace) Build(nGQL Builder) error {
+	if cs.SpaceName == "" {
+		return fmt.Errorf("norm: %w, build create space clause failed, space name is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("CREATE SPACE ")
+	if cs.IfNotExists {
+		nGQL.WriteString("IF NOT EXISTS ")
+	}
+	nGQL.WriteString(cs.SpaceName)
+	if cs.AsSpace != "" {
+		nGQL.WriteString(" AS ")
+		nGQL.WriteString(cs.AsSpace)
+		return nil
+	}
+	if cs.VIDType == "" {
+		return fmt.Errorf("norm: %w, build create space clause failed, vid type is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString(" (")
+	if cs.PartitionNum > 0 {
+		nGQL.WriteString("partition_num = ")
+		nGQL.WriteString(strconv.Itoa(cs.PartitionNum))
+		nGQL.WriteString(", ")
+	}
+	if cs.ReplicaFactor > 0 {
+		nGQL.WriteString("replica_factor = ")
+		nGQL.WriteString(strconv.Itoa(cs.ReplicaFactor))
+		nGQL.WriteString(", ")
+	}
+	nGQL.WriteString("vid_type = ")
+	nGQL.WriteString(cs.VIDType)
+	nGQL.WriteByte(')')
+	if cs.Comment != "" {
+		nGQL.WriteString(" COMMENT = ")
+		nGQL.WriteString(strconv.Quote(cs.Comment))
+	}
+	return nil
+}
+
+// SpaceOption configures the space to be created
+type SpaceOption func(*CreateSpace)
+
+// WithPartitionNum sets the number of partitions of the space
+func WithPartitionNum(partitionNum int) SpaceOption {
+	return func(cs *CreateSpace) {
+		cs.PartitionNum = partitionNum
+	}
+}
+
+// WithReplicaFactor sets the number of replicas of the space
+func WithReplicaFactor(replicaFactor int) SpaceOption {
+	return func(cs *CreateSpace) {
+		cs.ReplicaFactor = replicaFactor
+	}
+}
+
+// WithSpaceComment sets the comment of the space
+func WithSpaceComment(comment string) SpaceOption {
+	return func(cs *CreateSpace) {
+		cs.Comment = comment
+	}
+}
+
+// WithIfNotExists creates the space only if it does not exist
+func WithIfNotExists() SpaceOption {
+	return func(cs *CreateSpace) {
+		cs.IfNotExists = true
+	}
+}
@@ -0,0 +1,42 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+)
+
+func TestCreateSpace(t *testing.T) {
+	tests := []struct {
+		clauses []clause.Interface
+		gqlWant string
+		errWant error
+	}{
+		{
+			clauses: []clause.Interface{clause.CreateSpace{SpaceName: "test", VIDType: "FIXED_STRING(32)"}},
+			gqlWant: `CREATE SPACE test (vid_type = FIXED_STRING(32))`,
+		},
+		{
+			clauses: []clause.Interface{clause.CreateSpace{IfNotExists: true, SpaceName: "test", PartitionNum: 15, ReplicaFactor: 1, VIDType: "INT64", Comment: "test space"}},
+			gqlWant: `CREATE SPACE IF NOT EXISTS test (partition_num = 15, replica_factor = 1, vid_type = INT64) COMMENT = "test space"`,
+		},
+		{
+			clauses: []clause.Interface{clause.CreateSpace{SpaceName: "test2", AsSpace: "test", VIDType: "INT64"}},
+			gqlWant: `CREATE SPACE test2 AS test`,
+		},
+		{
+			clauses: []clause.Interface{clause.CreateSpace{SpaceName: "test"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.CreateSpace{VIDType: "INT64"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			testBuildClauses(t, tt.clauses, tt.gqlWant, tt.errWant)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package clause
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/haysons/norm/resolver"
 )
@@ -42,6 +41,13 @@ func (ct CreateTag) Build(nGQL Builder) error {
 		nGQL.WriteString(", TTL_COL = ")
 		nGQL.WriteString(strconv.Quote(ttlCols[0]))
 	}
+	if comment := ct.Tag.Comment; comment != "" {
+		if len(ttlCols) == 1 && ttlDuration != "" {
+			nGQL.WriteByte(',')
+		}
+		nGQL.WriteString(" COMMENT = ")
+		nGQL.WriteString(strconv.Quote(comment))
+	}
 	return nil
 }
 
@@ -62,15 +68,7 @@ func buildProps(props []*resolver.Prop, nGQL Builder) ([]string, string, error)
 		}
 		if prop.Default != "" {
 			nGQL.WriteString(" DEFAULT ")
-			switch strings.ToLower(prop.DataType) {
-			case "string", "fixed_string":
-				if prop.Default == "''" || prop.Default == "\"\"" {
-					prop.Default = ""
-				}
-				nGQL.WriteString(strconv.Quote(prop.Default))
-			default:
-				nGQL.WriteString(prop.Default)
-			}
+			nGQL.WriteString(prop.DefaultNGQL())
 		}
 		if prop.Comment != "" {
 			nGQL.WriteString(" COMMENT ")
@@ -49,6 +49,9 @@ func TestCreateTag(t *testing.T) {
 					tag.SetProps(
 						&resolver.Prop{Name: "name", DataType: "string", Default: "default name"},
 						&resolver.Prop{Name: "age", DataType: "int", Default: "20"},
+						&resolver.Prop{Name: "nickname", DataType: "fixed_string(16)", Default: "none"},
+						&resolver.Prop{Name: "alias", DataType: "FIXED_STRING(16)", Default: `"no\"ne"`},
+						&resolver.Prop{Name: "team", DataType: "string", Default: "''"},
 					)
 					return clause.CreateTag{
 						IfNotExists: true,
@@ -56,7 +59,7 @@ func TestCreateTag(t *testing.T) {
 					}
 				}(),
 			},
-			gqlWant: `CREATE TAG IF NOT EXISTS player_with_default(name string DEFAULT "default name", age int DEFAULT 20)`,
+			gqlWant: `CREATE TAG IF NOT EXISTS player_with_default(name string DEFAULT "default name", age int DEFAULT 20, nickname fixed_string(16) DEFAULT "none", alias FIXED_STRING(16) DEFAULT "no\"ne", team string DEFAULT "")`,
 		},
 		{
 			clauses: []clause.Interface{
@@ -77,6 +80,20 @@ func TestCreateTag(t *testing.T) {
 			},
 			gqlWant: `CREATE TAG IF NOT EXISTS woman(name string, age int, married bool, salary double, create_time timestamp) TTL_DURATION = 100, TTL_COL = "create_time"`,
 		},
+		{
+			clauses: []clause.Interface{
+				func() clause.CreateTag {
+					tag := &resolver.VertexTag{TagName: "woman", Comment: `the "women"`}
+					tag.SetProps(
+						&resolver.Prop{Name: "name", DataType: "string"},
+					)
+					return clause.CreateTag{
+						Tag: tag,
+					}
+				}(),
+			},
+			gqlWant: `CREATE TAG woman(name string) COMMENT = "the \"women\""`,
+		},
 		{
 			clauses: []clause.Interface{
 				func() clause.CreateTag {
@@ -0,0 +1,25 @@
+package clause
+
+type DropSpace struct {
+	IfExists  bool
+	SpaceName string
+}
+
+const DropSpaceName = "DROP_SPACE"
+
+func (ds DropSpace) Name() string {
+	return DropSpaceName
+}
+
+func (ds DropSpace) MergeIn(clause *Clause) {
+	clause.Expression = ds
+}
+
+func (ds DropSpace) Build(nGQL Builder) error {
+	nGQL.WriteString("DROP SPACE ")
+	if ds.IfExists {
+		nGQL.WriteString("IF EXISTS ")
+	}
+	nGQL.WriteString(ds.SpaceName)
+	return nil
+}
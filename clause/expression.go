@@ -25,16 +25,22 @@ type Expr struct {
 	Vars []any
 }
 
+// ParamBuilder is a Builder that collects the arguments of expressions as query parameters. When an expression is
+// built into a ParamBuilder, its arguments are registered through AddParam and the returned parameter reference,
+// such as $p1, is written into the statement instead of the literal value.
+type ParamBuilder interface {
+	Builder
+	AddParam(value any) string
+}
+
 // Build raw expression
 func (expr Expr) Build(builder Builder) error {
 	var idx int
 	for _, v := range []byte(expr.Str) {
 		if v == '?' && len(expr.Vars) > idx {
-			valFmt, err := expr.formatValue(expr.Vars[idx])
-			if err != nil {
+			if err := expr.writeValue(builder, expr.Vars[idx]); err != nil {
 				return err
 			}
-			builder.WriteString(valFmt)
 			idx++
 		} else {
 			builder.WriteByte(v)
@@ -42,34 +48,92 @@ func (expr Expr) Build(builder Builder) error {
 	}
 	if idx < len(expr.Vars) {
 		for _, v := range expr.Vars[idx:] {
-			valFmt, err := expr.formatValue(v)
-			if err != nil {
+			if err := expr.writeValue(builder, v); err != nil {
 				return err
 			}
-			builder.WriteString(valFmt)
 		}
 	}
 	return nil
 }
 
-func (expr Expr) formatValue(value any) (string, error) {
+func (expr Expr) writeValue(builder Builder, value any) error {
 	switch v := value.(type) {
 	case Expr:
-		exprBuilder := new(strings.Builder)
-		err := v.Build(exprBuilder)
-		if err != nil {
-			return "", err
-		}
-		return exprBuilder.String(), nil
+		return v.Build(builder)
 	case *Expr:
-		exprBuilder := new(strings.Builder)
-		err := v.Build(exprBuilder)
-		if err != nil {
-			return "", err
+		return v.Build(builder)
+	}
+	// values that cannot be sent as parameters, such as time.Time, are still written as literals
+	if paramBuilder, ok := builder.(ParamBuilder); ok {
+		if param, ok := paramValue(reflect.ValueOf(value)); ok {
+			builder.WriteString(paramBuilder.AddParam(param))
+			return nil
+		}
+	}
+	valFmt, err := resolver.FormatSimpleValue("", reflect.ValueOf(value))
+	if err != nil {
+		return err
+	}
+	builder.WriteString(valFmt)
+	return nil
+}
+
+// paramValue converts the value into one of the types accepted by the nebula-go parameter api
+func paramValue(value reflect.Value) (any, bool) {
+	if !value.IsValid() {
+		return nil, true
+	}
+	// the custom types are written as the literals they format themselves into
+	if resolver.IsCustomFormat(value.Type()) {
+		return nil, false
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	case reflect.String:
+		return value.String(), true
+	case reflect.Pointer:
+		if value.IsNil() {
+			return nil, true
+		}
+		return paramValue(value.Elem())
+	case reflect.Slice, reflect.Array:
+		list := make([]any, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elem, ok := paramValue(value.Index(i))
+			if !ok {
+				return nil, false
+			}
+			list = append(list, elem)
+		}
+		return list, true
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		m := make(map[string]any, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			elem, ok := paramValue(iter.Value())
+			if !ok {
+				return nil, false
+			}
+			m[iter.Key().String()] = elem
+		}
+		return m, true
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil, true
 		}
-		return exprBuilder.String(), nil
+		return paramValue(value.Elem())
 	default:
-		return resolver.FormatSimpleValue("", reflect.ValueOf(value))
+		return nil, false
 	}
 }
 
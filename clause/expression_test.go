@@ -2,7 +2,10 @@ package clause
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func Test_vertexIDExpr(t *testing.T) {
@@ -36,3 +39,44 @@ func Test_vertexIDExpr(t *testing.T) {
 		})
 	}
 }
+
+func Test_paramValue(t *testing.T) {
+	str := "hayson"
+	tests := []struct {
+		value  any
+		want   any
+		wantOk bool
+	}{
+		{value: true, want: true, wantOk: true},
+		{value: int8(1), want: int64(1), wantOk: true},
+		{value: uint32(1), want: int64(1), wantOk: true},
+		{value: float32(1.5), want: 1.5, wantOk: true},
+		{value: "hayson", want: "hayson", wantOk: true},
+		{value: &str, want: "hayson", wantOk: true},
+		{value: nil, want: nil, wantOk: true},
+		{value: []string{"n1", "n2"}, want: []any{"n1", "n2"}, wantOk: true},
+		{value: map[string]int{"a": 1}, want: map[string]any{"a": int64(1)}, wantOk: true},
+		{value: map[int]int{1: 1}, wantOk: false},
+		{value: time.Now(), wantOk: false},
+		{value: level(1), wantOk: false},
+		{value: []level{1, 2}, wantOk: false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, ok := paramValue(reflect.ValueOf(tt.value))
+			if ok != tt.wantOk {
+				t.Errorf("paramValue() ok = %v, wantOk %v", ok, tt.wantOk)
+				return
+			}
+			if ok && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("paramValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type level int
+
+func (l level) NGQLValue() (string, error) {
+	return strconv.Quote("L" + strconv.Itoa(int(l))), nil
+}
@@ -0,0 +1,43 @@
+package clause
+
+import (
+	"fmt"
+)
+
+// FindPath clause, the path type is one of SHORTEST, SINGLE SHORTEST, ALL and NOLOOP
+type FindPath struct {
+	PathType string
+	WithProp bool
+}
+
+const FindPathName = "FIND_PATH"
+
+const (
+	PathTypeShortest       = "SHORTEST"
+	PathTypeSingleShortest = "SINGLE SHORTEST"
+	PathTypeAll            = "ALL"
+	PathTypeNoLoop         = "NOLOOP"
+)
+
+func (fp FindPath) Name() string {
+	return FindPathName
+}
+
+func (fp FindPath) MergeIn(clause *Clause) {
+	clause.Expression = fp
+}
+
+func (fp FindPath) Build(nGQL Builder) error {
+	switch fp.PathType {
+	case PathTypeShortest, PathTypeSingleShortest, PathTypeAll, PathTypeNoLoop:
+	default:
+		return fmt.Errorf("norm: %w, path type must be %s, %s, %s or %s", ErrInvalidClauseParams, PathTypeShortest, PathTypeSingleShortest, PathTypeAll, PathTypeNoLoop)
+	}
+	nGQL.WriteString("FIND ")
+	nGQL.WriteString(fp.PathType)
+	nGQL.WriteString(" PATH")
+	if fp.WithProp {
+		nGQL.WriteString(" WITH PROP")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+)
+
+func TestFindPath(t *testing.T) {
+	tests := []struct {
+		clauses []clause.Interface
+		gqlWant string
+		errWant error
+	}{
+		{
+			clauses: []clause.Interface{clause.FindPath{PathType: clause.PathTypeShortest}},
+			gqlWant: "FIND SHORTEST PATH",
+		},
+		{
+			clauses: []clause.Interface{clause.FindPath{PathType: clause.PathTypeAll, WithProp: true}},
+			gqlWant: "FIND ALL PATH WITH PROP",
+		},
+		{
+			clauses: []clause.Interface{clause.FindPath{PathType: clause.PathTypeSingleShortest}, clause.FindPath{PathType: clause.PathTypeNoLoop}},
+			gqlWant: "FIND NOLOOP PATH",
+		},
+		{
+			clauses: []clause.Interface{clause.FindPath{PathType: "LONGEST"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.To{VID: []string{"team204", "player100"}}},
+			gqlWant: `TO "team204", "player100"`,
+		},
+		{
+			clauses: []clause.Interface{clause.To{VID: 1.2}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.Upto{Steps: 3}},
+			gqlWant: "UPTO 3 STEPS",
+		},
+		{
+			clauses: []clause.Interface{clause.Upto{}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			testBuildClauses(t, tt.clauses, tt.gqlWant, tt.errWant)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package clause
 import (
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/haysons/norm/resolver"
 )
@@ -11,6 +13,7 @@ type InsertEdge struct {
 	IfNotExists bool
 	Edges       reflect.Value
 	edgeSchema  *resolver.EdgeSchema
+	now         time.Time
 }
 
 const InsertEdgeName = "INSERT_EDGE"
@@ -29,6 +32,7 @@ func (ie InsertEdge) Build(nGQL Builder) error {
 		nGQL.WriteString("IF NOT EXISTS ")
 	}
 	ie.Edges = reflect.Indirect(ie.Edges)
+	ie.now = time.Now()
 	switch ie.Edges.Kind() {
 	case reflect.Struct:
 		var err error
@@ -68,6 +72,31 @@ func (ie InsertEdge) Build(nGQL Builder) error {
 	return nil
 }
 
+// ValueLens returns the length of the value of each edge in VALUES, such as "player100"->"player101"@0:(95), the edges
+// must be a slice or an array, it measures the rows of the statement without building it
+func (ie InsertEdge) ValueLens() ([]int, error) {
+	ie.Edges = reflect.Indirect(ie.Edges)
+	if ie.Edges.Kind() != reflect.Slice && ie.Edges.Kind() != reflect.Array {
+		return nil, fmt.Errorf("norm: %w, measure insert edge values failed, dest must be slice or array", ErrInvalidClauseParams)
+	}
+	var err error
+	ie.edgeSchema, err = resolver.ParseEdge(ie.Edges.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+	ie.now = time.Now()
+	lens := make([]int, ie.Edges.Len())
+	var sb strings.Builder
+	for i := range lens {
+		sb.Reset()
+		if err = ie.buildPropValues(reflect.Indirect(ie.Edges.Index(i)), &sb); err != nil {
+			return nil, err
+		}
+		lens[i] = sb.Len()
+	}
+	return lens, nil
+}
+
 func (ie InsertEdge) buildPropNames(nGQL Builder) {
 	nGQL.WriteString(ie.edgeSchema.GetTypeName())
 	nGQL.WriteString("(")
@@ -85,7 +114,7 @@ func (ie InsertEdge) buildPropValues(curValue reflect.Value, nGQL Builder) error
 	nGQL.WriteString(":(")
 	props := ie.edgeSchema.GetProps()
 	for i, prop := range props {
-		valueFmt, err := resolver.FormatSimpleValue(prop.SdkType, curValue.FieldByIndex(prop.StructField.Index))
+		valueFmt, err := resolver.FormatSimpleValue(prop.SdkType, insertPropValue(prop, curValue, ie.now))
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/haysons/norm/clause"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestInsertEdge(t *testing.T) {
@@ -77,6 +78,33 @@ func TestInsertEdge(t *testing.T) {
 	}
 }
 
+func TestInsertEdgeValueLens(t *testing.T) {
+	tests := []struct {
+		edges   any
+		want    []int
+		errWant error
+	}{
+		{
+			edges: []*edge2{{SrcID: "12", DstID: "13", Name: "n1", Age: 1}, {SrcID: "13", DstID: "14", Rank: 2, Name: "n22", Age: 2}},
+			want:  []int{len(`"12"->"13":("n1", 1)`), len(`"13"->"14"@2:("n22", 2)`)},
+		},
+		{edges: []edge2{}, want: []int{}},
+		{edges: edge2{SrcID: "12", DstID: "13"}, errWant: clause.ErrInvalidClauseParams},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			lens, err := clause.InsertEdge{Edges: reflect.ValueOf(tt.edges)}.ValueLens()
+			if tt.errWant != nil {
+				assert.ErrorIs(t, err, tt.errWant)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, lens)
+			}
+		})
+	}
+}
+
 type edge1 struct {
 	SrcID string `norm:"edge_src_id"`
 	DstID string `norm:"edge_dst_id"`
@@ -3,7 +3,10 @@ package clause
 import (
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
+	"github.com/haysons/norm/internal/utils"
 	"github.com/haysons/norm/resolver"
 )
 
@@ -11,6 +14,7 @@ type InsertVertex struct {
 	IfNotExists  bool
 	Vertexes     reflect.Value
 	vertexSchema *resolver.VertexSchema
+	now          time.Time
 }
 
 const InsertVertexName = "INSERT_VERTEX"
@@ -29,6 +33,7 @@ func (iv InsertVertex) Build(nGQL Builder) error {
 		nGQL.WriteString("IF NOT EXISTS ")
 	}
 	iv.Vertexes = reflect.Indirect(iv.Vertexes)
+	iv.now = time.Now()
 	switch iv.Vertexes.Kind() {
 	case reflect.Struct:
 		var err error
@@ -68,6 +73,31 @@ func (iv InsertVertex) Build(nGQL Builder) error {
 	}
 }
 
+// ValueLens returns the length of the value of each vertex in VALUES, such as "player100":("Tim", 42), the vertices
+// must be a slice or an array, it measures the rows of the statement without building it
+func (iv InsertVertex) ValueLens() ([]int, error) {
+	iv.Vertexes = reflect.Indirect(iv.Vertexes)
+	if iv.Vertexes.Kind() != reflect.Slice && iv.Vertexes.Kind() != reflect.Array {
+		return nil, fmt.Errorf("norm: %w, measure insert vertex values failed, dest must be slice or array", ErrInvalidClauseParams)
+	}
+	var err error
+	iv.vertexSchema, err = resolver.ParseVertex(iv.Vertexes.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+	iv.now = time.Now()
+	lens := make([]int, iv.Vertexes.Len())
+	var sb strings.Builder
+	for i := range lens {
+		sb.Reset()
+		if err = iv.buildPropValue(reflect.Indirect(iv.Vertexes.Index(i)), &sb); err != nil {
+			return nil, err
+		}
+		lens[i] = sb.Len()
+	}
+	return lens, nil
+}
+
 func (iv InsertVertex) buildTagProps(nGQL Builder) {
 	tags := iv.vertexSchema.GetTags()
 	for i, t := range tags {
@@ -95,7 +125,7 @@ func (iv InsertVertex) buildPropValue(curValue reflect.Value, nGQL Builder) erro
 	for j, t := range tags {
 		props := t.GetProps()
 		for k, p := range props {
-			valueFmt, err := resolver.FormatSimpleValue(p.SdkType, curValue.FieldByIndex(p.StructField.Index))
+			valueFmt, err := resolver.FormatSimpleValue(p.SdkType, insertPropValue(p, curValue, iv.now))
 			if err != nil {
 				return err
 			}
@@ -111,3 +141,19 @@ func (iv InsertVertex) buildPropValue(curValue reflect.Value, nGQL Builder) erro
 	nGQL.WriteString(")")
 	return nil
 }
+
+// insertPropValue returns the value of the prop to be inserted, the zero value of the auto time props is replaced by now
+func insertPropValue(p *resolver.Prop, curValue reflect.Value, now time.Time) reflect.Value {
+	value := utils.FieldByIndexOrZero(curValue, p.StructField.Index)
+	if !value.IsZero() {
+		return value
+	}
+	variant := p.AutoCreateTime
+	if variant == "" {
+		variant = p.AutoUpdateTime
+	}
+	if variant == "" {
+		return value
+	}
+	return resolver.AutoTimeValue(p.Type, variant, now)
+}
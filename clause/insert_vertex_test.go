@@ -3,9 +3,12 @@ package clause_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/haysons/norm/clause"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestInsertVertex(t *testing.T) {
@@ -51,6 +54,13 @@ func TestInsertVertex(t *testing.T) {
 			clauses: []clause.Interface{clause.InsertVertex{IfNotExists: true, Vertexes: reflect.ValueOf([]v3{v31, *v32})}},
 			gqlWant: `INSERT VERTEX IF NOT EXISTS t3(p1), t4(p2) VALUES "21":(321, "hello"), "22":(456, "world")`,
 		},
+		{
+			clauses: []clause.Interface{clause.InsertVertex{Vertexes: reflect.ValueOf([]t5{
+				{VID: "31", Name: "n1", t5Base: &t5Base{Version: 1}, Audit: t5Base{Version: 2}},
+				{VID: "32", Name: "n2"},
+			})}},
+			gqlWant: `INSERT VERTEX t5(name, version, audit_version) VALUES "31":("n1", 1, 2), "32":("n2", 0, 0)`,
+		},
 		{
 			clauses: []clause.Interface{clause.InsertVertex{IfNotExists: true}},
 			errWant: clause.ErrInvalidClauseParams,
@@ -63,6 +73,31 @@ func TestInsertVertex(t *testing.T) {
 	}
 }
 
+func TestInsertVertexValueLens(t *testing.T) {
+	tests := []struct {
+		vertexes any
+		want     []int
+		errWant  error
+	}{
+		{vertexes: []t2{{VID: "11", Name: "n1", Age: 12}, {VID: "1200", Name: "n2", Age: 1}}, want: []int{len(`"11":("n1", 12)`), len(`"1200":("n2", 1)`)}},
+		{vertexes: &[]*v3{{VID: "21", T1: &t3{P1: 321}, T2: t4{P2: "hello"}}}, want: []int{len(`"21":(321, "hello")`)}},
+		{vertexes: []t2{}, want: []int{}},
+		{vertexes: t2{VID: "11"}, errWant: clause.ErrInvalidClauseParams},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			lens, err := clause.InsertVertex{Vertexes: reflect.ValueOf(tt.vertexes)}.ValueLens()
+			if tt.errWant != nil {
+				assert.ErrorIs(t, err, tt.errWant)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, lens)
+			}
+		})
+	}
+}
+
 type t1 struct {
 	VID string `norm:"vertex_id"`
 }
@@ -114,3 +149,64 @@ type t4 struct {
 func (t t4) VertexTagName() string {
 	return "t4"
 }
+
+type t5Base struct {
+	Version int64
+}
+
+type t5 struct {
+	VID  string `norm:"vertex_id"`
+	Name string
+	*t5Base
+	Audit t5Base `norm:"embedded;prefix:audit_"`
+}
+
+func (t t5) VertexID() string {
+	return t.VID
+}
+
+func (t t5) VertexTagName() string {
+	return "t5"
+}
+
+func TestInsertVertexAutoTime(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.Local)
+	tests := []struct {
+		vertex  any
+		gqlWant string
+	}{
+		{
+			vertex:  t6{VID: "31", Name: "n1", CreatedAt: created, UpdatedAt: 1735787045, UpdatedMilli: 1735787045000},
+			gqlWant: `^INSERT VERTEX t6\(name, created_at, updated_at, updated_milli\) VALUES "31":\("n1", datetime\("2025-01-02T03:04:05"\), 1735787045, 1735787045000\)$`,
+		},
+		{
+			vertex:  &t6{VID: "32", Name: "n2"},
+			gqlWant: `^INSERT VERTEX t6\(name, created_at, updated_at, updated_milli\) VALUES "32":\("n2", datetime\("[0-9T:.-]+"\), [0-9]{10}, [0-9]{13}\)$`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			gqlBuilder := new(strings.Builder)
+			err := clause.InsertVertex{Vertexes: reflect.ValueOf(tt.vertex)}.Build(gqlBuilder)
+			if assert.NoError(t, err) {
+				assert.Regexp(t, tt.gqlWant, gqlBuilder.String())
+			}
+		})
+	}
+}
+
+type t6 struct {
+	VID          string    `norm:"vertex_id"`
+	Name         string    `norm:"prop:name"`
+	CreatedAt    time.Time `norm:"prop:created_at;autoCreateTime"`
+	UpdatedAt    int64     `norm:"prop:updated_at;autoUpdateTime"`
+	UpdatedMilli int64     `norm:"prop:updated_milli;autoUpdateTime:milli"`
+}
+
+func (t t6) VertexID() string {
+	return t.VID
+}
+
+func (t t6) VertexTagName() string {
+	return "t6"
+}
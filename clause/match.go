@@ -0,0 +1,75 @@
+package clause
+
+import (
+	"fmt"
+)
+
+// Match openCypher MATCH clause, multiple patterns are separated by commas
+type Match struct {
+	Patterns []Expression
+}
+
+const MatchName = "MATCH"
+
+func (match Match) Name() string {
+	return MatchName
+}
+
+func (match Match) MergeIn(clause *Clause) {
+	exist, ok := clause.Expression.(Match)
+	if !ok {
+		clause.Expression = match
+		return
+	}
+	exist.Patterns = append(exist.Patterns, match.Patterns...)
+	clause.Expression = exist
+}
+
+func (match Match) Build(nGQL Builder) error {
+	nGQL.WriteString("MATCH ")
+	return buildPatterns(match.Patterns, nGQL)
+}
+
+// OptionalMatch openCypher OPTIONAL MATCH clause
+type OptionalMatch struct {
+	Patterns []Expression
+}
+
+const OptionalMatchName = "OPTIONAL_MATCH"
+
+func (om OptionalMatch) Name() string {
+	return OptionalMatchName
+}
+
+func (om OptionalMatch) MergeIn(clause *Clause) {
+	exist, ok := clause.Expression.(OptionalMatch)
+	if !ok {
+		clause.Expression = om
+		return
+	}
+	exist.Patterns = append(exist.Patterns, om.Patterns...)
+	clause.Expression = exist
+}
+
+func (om OptionalMatch) Build(nGQL Builder) error {
+	nGQL.WriteString("OPTIONAL MATCH ")
+	return buildPatterns(om.Patterns, nGQL)
+}
+
+func buildPatterns(patterns []Expression, nGQL Builder) error {
+	if len(patterns) == 0 {
+		return fmt.Errorf("norm: %w, match patterns is empty", ErrInvalidClauseParams)
+	}
+	for i, pattern := range patterns {
+		if pattern == nil {
+			return fmt.Errorf("norm: %w, match pattern is nil", ErrInvalidClauseParams)
+		}
+		if err := pattern.Build(nGQL); err != nil {
+			return err
+		}
+		if i != len(patterns)-1 {
+			nGQL.WriteString(", ")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		clauses []clause.Interface
+		gqlWant string
+		errWant error
+	}{
+		{
+			clauses: []clause.Interface{clause.Match{Patterns: []clause.Expression{clause.Expr{Str: "(v:player)"}}}},
+			gqlWant: "MATCH (v:player)",
+		},
+		{
+			clauses: []clause.Interface{clause.Match{Patterns: []clause.Expression{clause.Node("v", t2{})}}, clause.Match{Patterns: []clause.Expression{clause.Node("v2", "t1")}}},
+			gqlWant: "MATCH (v:t2), (v2:t1)",
+		},
+		{
+			clauses: []clause.Interface{clause.OptionalMatch{Patterns: []clause.Expression{clause.Node("n").Out("").Node("l")}}},
+			gqlWant: "OPTIONAL MATCH (n)-[]->(l)",
+		},
+		{
+			clauses: []clause.Interface{clause.Match{}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.OptionalMatch{Patterns: []clause.Expression{nil}}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			testBuildClauses(t, tt.clauses, tt.gqlWant, tt.errWant)
+		})
+	}
+}
+
+func TestReturnWith(t *testing.T) {
+	tests := []struct {
+		clauses []clause.Interface
+		gqlWant string
+		errWant error
+	}{
+		{
+			clauses: []clause.Interface{clause.Return{ExprList: []string{"v.player.name AS name"}}, clause.Return{ExprList: []string{"v.player.age AS age"}}},
+			gqlWant: "RETURN v.player.name AS name, v.player.age AS age",
+		},
+		{
+			clauses: []clause.Interface{clause.Return{ExprList: []string{"v"}, Distinct: true}},
+			gqlWant: "RETURN DISTINCT v",
+		},
+		{
+			clauses: []clause.Interface{clause.Return{}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.With{ExprList: []string{"v", "count(*) AS c"}}},
+			gqlWant: "WITH v, count(*) AS c",
+		},
+		{
+			clauses: []clause.Interface{clause.With{ExprList: []string{""}}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.Unwind{Expr: "[1, 2, 3]", Alias: "n"}},
+			gqlWant: "UNWIND [1, 2, 3] AS n",
+		},
+		{
+			clauses: []clause.Interface{clause.Unwind{Expr: "[1, 2, 3]"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.Skip{Offset: 3}, clause.Limit{Limit: 5}},
+			gqlWant: "SKIP 3 LIMIT 5",
+		},
+		{
+			clauses: []clause.Interface{clause.Skip{Offset: -1}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			testBuildClauses(t, tt.clauses, tt.gqlWant, tt.errWant)
+		})
+	}
+}
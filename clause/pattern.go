@@ -0,0 +1,284 @@
+package clause
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/haysons/norm/resolver"
+)
+
+// Pattern is an openCypher path pattern used by the MATCH clause, such as (v:player)-[e:follow]->(v2:player).
+// Nodes and relationships may be described by vertex and edge structs, in which case the tag names, edge type names
+// and property names are taken from the same metadata used by insert statements. Only the non-zero props of the
+// struct are written into the pattern as property filters.
+type Pattern struct {
+	name  string
+	elems []patternElem
+}
+
+type patternElem struct {
+	isRel     bool
+	alias     string
+	values    []any
+	direction patternDirection
+	minHops   int
+	maxHops   int
+}
+
+type patternDirection int
+
+const (
+	patternDirectionOut patternDirection = iota + 1
+	patternDirectionIn
+	patternDirectionBoth
+)
+
+// Node starts a new pattern with a node
+//
+// (v)
+// clause.Node("v")
+//
+// (v:player)
+// clause.Node("v", "player") or clause.Node("v", Player{})
+//
+// (v:player{name: "Tim Duncan"})
+// clause.Node("v", &Player{Name: "Tim Duncan"})
+func Node(alias string, vertex ...any) *Pattern {
+	p := &Pattern{}
+	return p.Node(alias, vertex...)
+}
+
+// Node appends a node to the pattern, see clause.Node for the accepted values
+func (p *Pattern) Node(alias string, vertex ...any) *Pattern {
+	p.elems = append(p.elems, patternElem{alias: alias, values: vertex})
+	return p
+}
+
+// Out appends an outgoing relationship to the pattern
+//
+// -[e:follow]->
+// pattern.Out("e", "follow") or pattern.Out("e", Follow{})
+//
+// -[e:follow|serve]->
+// pattern.Out("e", "follow", "serve")
+func (p *Pattern) Out(alias string, edge ...any) *Pattern {
+	return p.rel(alias, patternDirectionOut, edge)
+}
+
+// In appends an incoming relationship to the pattern
+//
+// <-[e:follow]-
+// pattern.In("e", Follow{})
+func (p *Pattern) In(alias string, edge ...any) *Pattern {
+	return p.rel(alias, patternDirectionIn, edge)
+}
+
+// Both appends a relationship of either direction to the pattern
+//
+// -[e:follow]-
+// pattern.Both("e", Follow{})
+func (p *Pattern) Both(alias string, edge ...any) *Pattern {
+	return p.rel(alias, patternDirectionBoth, edge)
+}
+
+func (p *Pattern) rel(alias string, direction patternDirection, edge []any) *Pattern {
+	p.elems = append(p.elems, patternElem{
+		isRel:     true,
+		alias:     alias,
+		values:    edge,
+		direction: direction,
+		minHops:   -1,
+		maxHops:   -1,
+	})
+	return p
+}
+
+// Hops makes the last relationship of the pattern variable-length, a negative max means no upper bound
+//
+// -[e:follow*1..3]->
+// pattern.Out("e", Follow{}).Hops(1, 3)
+//
+// -[e:follow*2]->
+// pattern.Out("e", Follow{}).Hops(2, 2)
+func (p *Pattern) Hops(min, max int) *Pattern {
+	for i := len(p.elems) - 1; i >= 0; i-- {
+		if p.elems[i].isRel {
+			p.elems[i].minHops = min
+			p.elems[i].maxHops = max
+			break
+		}
+	}
+	return p
+}
+
+// As names the whole path
+//
+// p = (v:player)-[e:follow]->(v2)
+// clause.Node("v", Player{}).Out("e", Follow{}).Node("v2").As("p")
+func (p *Pattern) As(name string) *Pattern {
+	p.name = name
+	return p
+}
+
+// Build pattern expression
+func (p *Pattern) Build(nGQL Builder) error {
+	if len(p.elems) == 0 {
+		return fmt.Errorf("norm: %w, pattern is empty", ErrInvalidClauseParams)
+	}
+	if p.name != "" {
+		nGQL.WriteString(p.name)
+		nGQL.WriteString(" = ")
+	}
+	var lastIsNode bool
+	for _, elem := range p.elems {
+		if elem.isRel {
+			// relationships must always be surrounded by nodes, fill in the anonymous node if necessary
+			if !lastIsNode {
+				nGQL.WriteString("()")
+			}
+			if err := elem.buildRel(nGQL); err != nil {
+				return err
+			}
+			lastIsNode = false
+			continue
+		}
+		if err := elem.buildNode(nGQL); err != nil {
+			return err
+		}
+		lastIsNode = true
+	}
+	if !lastIsNode {
+		nGQL.WriteString("()")
+	}
+	return nil
+}
+
+func (elem patternElem) buildNode(nGQL Builder) error {
+	nGQL.WriteByte('(')
+	nGQL.WriteString(elem.alias)
+	for _, v := range elem.values {
+		switch vertex := v.(type) {
+		case string:
+			if vertex == "" {
+				continue
+			}
+			nGQL.WriteByte(':')
+			nGQL.WriteString(vertex)
+		case []string:
+			for _, label := range vertex {
+				nGQL.WriteByte(':')
+				nGQL.WriteString(label)
+			}
+		default:
+			vertexValue := reflect.Indirect(reflect.ValueOf(vertex))
+			if vertexValue.Kind() != reflect.Struct {
+				return fmt.Errorf("norm: %w, build pattern failed, node must be a tag name or vertex struct", ErrInvalidClauseParams)
+			}
+			vertexSchema, err := resolver.ParseVertex(vertexValue.Type())
+			if err != nil {
+				return err
+			}
+			for _, tag := range vertexSchema.GetTags() {
+				nGQL.WriteByte(':')
+				nGQL.WriteString(tag.TagName)
+				if err = buildPatternProps(tag.GetProps(), vertexValue, nGQL); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	nGQL.WriteByte(')')
+	return nil
+}
+
+func (elem patternElem) buildRel(nGQL Builder) error {
+	if elem.direction == patternDirectionIn {
+		nGQL.WriteString("<-[")
+	} else {
+		nGQL.WriteString("-[")
+	}
+	nGQL.WriteString(elem.alias)
+	typeNames := make([]string, 0, len(elem.values))
+	var props []*resolver.Prop
+	var propsValue reflect.Value
+	for _, v := range elem.values {
+		switch edge := v.(type) {
+		case string:
+			if edge != "" {
+				typeNames = append(typeNames, edge)
+			}
+		case []string:
+			typeNames = append(typeNames, edge...)
+		default:
+			edgeValue := reflect.Indirect(reflect.ValueOf(edge))
+			if edgeValue.Kind() != reflect.Struct {
+				return fmt.Errorf("norm: %w, build pattern failed, relationship must be a edge type name or edge struct", ErrInvalidClauseParams)
+			}
+			edgeSchema, err := resolver.ParseEdge(edgeValue.Type())
+			if err != nil {
+				return err
+			}
+			typeNames = append(typeNames, edgeSchema.GetTypeName())
+			props = edgeSchema.GetProps()
+			propsValue = edgeValue
+		}
+	}
+	if len(typeNames) > 0 {
+		nGQL.WriteByte(':')
+		nGQL.WriteString(strings.Join(typeNames, "|"))
+	}
+	if elem.minHops >= 0 {
+		nGQL.WriteByte('*')
+		nGQL.WriteString(strconv.Itoa(elem.minHops))
+		if elem.maxHops != elem.minHops {
+			nGQL.WriteString("..")
+			if elem.maxHops >= 0 {
+				nGQL.WriteString(strconv.Itoa(elem.maxHops))
+			}
+		}
+	}
+	// property filters are only written when a single edge struct is given
+	if len(props) > 0 && len(typeNames) == 1 {
+		if err := buildPatternProps(props, propsValue, nGQL); err != nil {
+			return err
+		}
+	}
+	if elem.direction == patternDirectionOut {
+		nGQL.WriteString("]->")
+	} else {
+		nGQL.WriteString("]-")
+	}
+	return nil
+}
+
+func buildPatternProps(props []*resolver.Prop, value reflect.Value, nGQL Builder) error {
+	propsFmt := make([][2]string, 0, len(props))
+	for _, prop := range props {
+		// a nil tag pointer means that there is no filter on the tag
+		fieldValue, err := value.FieldByIndexErr(prop.StructField.Index)
+		if err != nil || fieldValue.IsZero() {
+			continue
+		}
+		valueFmt, err := resolver.FormatSimpleValue(prop.SdkType, fieldValue)
+		if err != nil {
+			return err
+		}
+		propsFmt = append(propsFmt, [2]string{prop.Name, valueFmt})
+	}
+	if len(propsFmt) == 0 {
+		return nil
+	}
+	nGQL.WriteByte('{')
+	for i, prop := range propsFmt {
+		nGQL.WriteString(prop[0])
+		nGQL.WriteString(": ")
+		nGQL.WriteString(prop[1])
+		if i != len(propsFmt)-1 {
+			nGQL.WriteString(", ")
+		}
+	}
+	nGQL.WriteByte('}')
+	return nil
+}
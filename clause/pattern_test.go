@@ -0,0 +1,81 @@
+package clause_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		pattern *clause.Pattern
+		want    string
+		errWant error
+	}{
+		{
+			pattern: clause.Node("v"),
+			want:    "(v)",
+		},
+		{
+			pattern: clause.Node("v", "player"),
+			want:    "(v:player)",
+		},
+		{
+			pattern: clause.Node("v", &t2{VID: "11", Name: "n1"}),
+			want:    `(v:t2{name: "n1"})`,
+		},
+		{
+			pattern: clause.Node("v", v3{T2: t4{P2: "hello"}}),
+			want:    `(v:t3:t4{p2: "hello"})`,
+		},
+		{
+			pattern: clause.Node("v", t2{}).Out("e", edge2{Age: 3}).Node("v2", t1{}),
+			want:    `(v:t2)-[e:e2{age: 3}]->(v2:t1)`,
+		},
+		{
+			pattern: clause.Node("v").In("e", "e1", "e2").Node("v2"),
+			want:    `(v)<-[e:e1|e2]-(v2)`,
+		},
+		{
+			pattern: clause.Node("v").Both("e", edge1{}).Hops(1, 3).Node("v2").As("p"),
+			want:    `p = (v)-[e:e1*1..3]-(v2)`,
+		},
+		{
+			pattern: clause.Node("v").Out("e").Hops(2, 2).Node("v2"),
+			want:    `(v)-[e*2]->(v2)`,
+		},
+		{
+			pattern: clause.Node("v").Out("e").Hops(2, -1),
+			want:    `(v)-[e*2..]->()`,
+		},
+		{
+			pattern: clause.Node("v", 1),
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			pattern: clause.Node("v").Out("e", t1{}),
+			errWant: errors.New("norm: parse edge failed, need to implement interface resolver.EdgeTypeNamer"),
+		},
+		{
+			pattern: &clause.Pattern{},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			builder := new(strings.Builder)
+			err := tt.pattern.Build(builder)
+			if tt.errWant != nil {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, builder.String())
+			}
+		})
+	}
+}
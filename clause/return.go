@@ -0,0 +1,49 @@
+package clause
+
+import "fmt"
+
+// Return openCypher RETURN clause
+type Return struct {
+	Distinct bool
+	ExprList []string
+}
+
+const ReturnName = "RETURN"
+
+func (r Return) Name() string {
+	return ReturnName
+}
+
+func (r Return) MergeIn(clause *Clause) {
+	exist, ok := clause.Expression.(Return)
+	if !ok {
+		clause.Expression = r
+		return
+	}
+	exist.ExprList = append(exist.ExprList, r.ExprList...)
+	exist.Distinct = r.Distinct
+	clause.Expression = exist
+}
+
+func (r Return) Build(nGQL Builder) error {
+	exprList := make([]string, 0, len(r.ExprList))
+	for _, expr := range r.ExprList {
+		if expr != "" {
+			exprList = append(exprList, expr)
+		}
+	}
+	if len(exprList) == 0 {
+		return fmt.Errorf("norm: %w, return expr is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("RETURN ")
+	if r.Distinct {
+		nGQL.WriteString("DISTINCT ")
+	}
+	for i, expr := range exprList {
+		nGQL.WriteString(expr)
+		if i != len(exprList)-1 {
+			nGQL.WriteString(", ")
+		}
+	}
+	return nil
+}
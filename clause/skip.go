@@ -0,0 +1,30 @@
+package clause
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Skip openCypher SKIP clause
+type Skip struct {
+	Offset int
+}
+
+const SkipName = "SKIP"
+
+func (skip Skip) Name() string {
+	return SkipName
+}
+
+func (skip Skip) MergeIn(clause *Clause) {
+	clause.Expression = skip
+}
+
+func (skip Skip) Build(nGQL Builder) error {
+	if skip.Offset < 0 {
+		return fmt.Errorf("norm: %w, skip can't be negative", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("SKIP ")
+	nGQL.WriteString(strconv.Itoa(skip.Offset))
+	return nil
+}
@@ -0,0 +1,29 @@
+package clause
+
+import (
+	"fmt"
+)
+
+type To struct {
+	VID any
+}
+
+const ToName = "TO"
+
+func (to To) Name() string {
+	return ToName
+}
+
+func (to To) MergeIn(clause *Clause) {
+	clause.Expression = to
+}
+
+func (to To) Build(nGQL Builder) error {
+	nGQL.WriteString("TO ")
+	vidExpr, err := vertexIDExpr(to.VID)
+	if err != nil {
+		return fmt.Errorf("norm: %w, build to clause failed, %v", ErrInvalidClauseParams, err)
+	}
+	nGQL.WriteString(vidExpr)
+	return nil
+}
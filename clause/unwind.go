@@ -0,0 +1,30 @@
+package clause
+
+import "fmt"
+
+// Unwind openCypher UNWIND clause
+type Unwind struct {
+	Expr  string
+	Alias string
+}
+
+const UnwindName = "UNWIND"
+
+func (u Unwind) Name() string {
+	return UnwindName
+}
+
+func (u Unwind) MergeIn(clause *Clause) {
+	clause.Expression = u
+}
+
+func (u Unwind) Build(nGQL Builder) error {
+	if u.Expr == "" || u.Alias == "" {
+		return fmt.Errorf("norm: %w, unwind expr and alias can't be empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("UNWIND ")
+	nGQL.WriteString(u.Expr)
+	nGQL.WriteString(" AS ")
+	nGQL.WriteString(u.Alias)
+	return nil
+}
@@ -53,7 +53,7 @@ func (ue UpdateEdge) Build(nGQL Builder) error {
 	for _, propName := range ue.Opts.PropNames {
 		propsName[propName] = true
 	}
-	propsUpdate, err := getPropsUpdateSet(ue.PropsUpdate, propsName)
+	propsUpdate, err := getPropsUpdateSet(ue.PropsUpdate, propsName, ue.IsUpsert)
 	if err != nil {
 		return fmt.Errorf("norm: %w, build update edge clause failed, %v", ErrInvalidClauseParams, err)
 	}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/haysons/norm/resolver"
 )
@@ -51,7 +52,7 @@ func (uv UpdateVertex) Build(nGQL Builder) error {
 	for _, propName := range uv.Opts.PropNames {
 		propsName[propName] = true
 	}
-	propsUpdate, err := getPropsUpdateSet(uv.TagUpdate, propsName)
+	propsUpdate, err := getPropsUpdateSet(uv.TagUpdate, propsName, uv.IsUpsert)
 	if err != nil {
 		return fmt.Errorf("norm: %w, build update vertex clause failed, %v", ErrInvalidClauseParams, err)
 	}
@@ -79,7 +80,9 @@ func (uv UpdateVertex) Build(nGQL Builder) error {
 	return nil
 }
 
-func getPropsUpdateSet(propsUpdate any, needUpdate map[string]bool) ([][2]string, error) {
+// getPropsUpdateSet returns the props to be set and their values sorted by the prop names for the map, or in the order of
+// the fields for the struct, the auto time props are only filled for the struct
+func getPropsUpdateSet(propsUpdate any, needUpdate map[string]bool, isUpsert bool) ([][2]string, error) {
 	propsUpdateSet := make([][2]string, 0)
 	switch prop := propsUpdate.(type) {
 	case map[string]any:
@@ -121,15 +124,34 @@ func getPropsUpdateSet(propsUpdate any, needUpdate map[string]bool) ([][2]string
 		propsValue := reflect.Indirect(reflect.ValueOf(propsUpdate))
 		switch propsValue.Kind() {
 		case reflect.Struct:
-			propsType := propsValue.Type()
-			for i := 0; i < propsType.NumField(); i++ {
-				structField := propsType.Field(i)
-				if structField.Anonymous || !structField.IsExported() {
+			now := time.Now()
+			for _, structField := range resolver.StructFields(propsValue.Type()) {
+				// the props of a nil embedded struct pointer are not updated
+				fieldValue, err := propsValue.FieldByIndexErr(structField.Index)
+				if err != nil {
 					continue
 				}
 				propName := resolver.GetPropName(structField)
 				sdkType := resolver.GetValueSdkType(structField)
-				fieldValue := propsValue.Field(i)
+				// the auto update time props are always updated to now, whatever value they hold
+				if variant := resolver.GetFieldAutoTime(structField, resolver.TagSettingAutoUpdateTime); variant != "" {
+					propValue, err := resolver.FormatSimpleValue(sdkType, resolver.AutoTimeValue(structField.Type, variant, now))
+					if err != nil {
+						return nil, err
+					}
+					propsUpdateSet = append(propsUpdateSet, [2]string{propName, propValue})
+					continue
+				}
+				// the zero auto create time props are filled by UPSERT only when it inserts the vertex or edge, the time
+				// of an existing one is kept
+				if variant := resolver.GetFieldAutoTime(structField, resolver.TagSettingAutoCreateTime); variant != "" && isUpsert && fieldValue.IsZero() {
+					propValue, err := resolver.FormatSimpleValue(sdkType, resolver.AutoTimeValue(structField.Type, variant, now))
+					if err != nil {
+						return nil, err
+					}
+					propsUpdateSet = append(propsUpdateSet, [2]string{propName, "coalesce(" + propName + ", " + propValue + ")"})
+					continue
+				}
 				if len(needUpdate) > 0 && needUpdate[propName] {
 					propValue, err := resolver.FormatSimpleValue(sdkType, fieldValue)
 					if err != nil {
@@ -165,7 +187,7 @@ func getPropsUpdateSet(propsUpdate any, needUpdate map[string]bool) ([][2]string
 				v := mapIter.Value().Interface()
 				updateMap[k] = v
 			}
-			return getPropsUpdateSet(updateMap, needUpdate)
+			return getPropsUpdateSet(updateMap, needUpdate, isUpsert)
 		default:
 			return nil, errors.New("update values must be map[string]any, struct or struct pointer")
 		}
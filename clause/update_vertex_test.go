@@ -2,9 +2,11 @@ package clause_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/haysons/norm/clause"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateVertex(t *testing.T) {
@@ -29,6 +31,10 @@ func TestUpdateVertex(t *testing.T) {
 			clauses: []clause.Interface{clause.UpdateVertex{VID: 101, TagUpdate: &playerTag{Name: "hayson", Age: 26}}},
 			gqlWant: `UPDATE VERTEX ON player 101 SET name = "hayson", age = 26`,
 		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: 101, TagUpdate: &playerVersionTag{playerTag: playerTag{Name: "hayson"}, PlayerBase: &PlayerBase{Version: 2}}}},
+			gqlWant: `UPDATE VERTEX ON player 101 SET name = "hayson", version = 2`,
+		},
 		{
 			clauses: []clause.Interface{clause.UpdateVertex{}},
 			errWant: clause.ErrInvalidClauseParams,
@@ -45,6 +51,48 @@ func TestUpdateVertex(t *testing.T) {
 	}
 }
 
+func TestUpdateVertexAutoTime(t *testing.T) {
+	tests := []struct {
+		clause  clause.UpdateVertex
+		gqlWant string
+	}{
+		{
+			clause:  clause.UpdateVertex{VID: "player101", TagUpdate: &playerAudit{Name: "hayson", UpdatedAt: 1735787045}},
+			gqlWant: `^UPDATE VERTEX ON player "player101" SET name = "hayson", updated_at = [0-9]{10}$`,
+		},
+		{
+			clause:  clause.UpdateVertex{VID: "player101", TagUpdate: &playerAudit{Name: "hayson"}},
+			gqlWant: `^UPDATE VERTEX ON player "player101" SET name = "hayson", updated_at = [0-9]{10}$`,
+		},
+		{
+			clause:  clause.UpdateVertex{VID: "player101", TagUpdate: &playerAudit{Name: "hayson", Age: 30}, Opts: clause.Options{PropNames: []string{"age"}}},
+			gqlWant: `^UPDATE VERTEX ON player "player101" SET age = 30, updated_at = [0-9]{10}$`,
+		},
+		{
+			clause:  clause.UpdateVertex{IsUpsert: true, VID: "player101", TagUpdate: &playerAudit{Name: "hayson"}},
+			gqlWant: `^UPSERT VERTEX ON player "player101" SET name = "hayson", created_at = coalesce\(created_at, [0-9]{10}\), updated_at = [0-9]{10}$`,
+		},
+		{
+			clause:  clause.UpdateVertex{IsUpsert: true, VID: "player101", TagUpdate: &playerAudit{Name: "hayson", CreatedAt: 1735787045}},
+			gqlWant: `^UPSERT VERTEX ON player "player101" SET name = "hayson", created_at = 1735787045, updated_at = [0-9]{10}$`,
+		},
+		{
+			clause:  clause.UpdateVertex{IsUpsert: true, VID: "player101", TagUpdate: &playerAudit{Name: "hayson", Age: 30}, Opts: clause.Options{PropNames: []string{"age"}}},
+			gqlWant: `^UPSERT VERTEX ON player "player101" SET age = 30, created_at = coalesce\(created_at, [0-9]{10}\), updated_at = [0-9]{10}$`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			gqlBuilder := new(strings.Builder)
+			err := tt.clause.Build(gqlBuilder)
+			if assert.NoError(t, err) {
+				assert.Regexp(t, tt.gqlWant, gqlBuilder.String())
+				assert.NotContains(t, gqlBuilder.String(), "updated_at = 1735787045")
+			}
+		})
+	}
+}
+
 type playerUpdate map[string]any
 
 func (m playerUpdate) VertexTagName() string {
@@ -60,3 +108,24 @@ type playerTag struct {
 func (m playerTag) VertexTagName() string {
 	return "player"
 }
+
+type PlayerBase struct {
+	Version int64
+}
+
+type playerVersionTag struct {
+	playerTag
+	*PlayerBase
+	Audit *PlayerBase `norm:"embedded;prefix:audit_"`
+}
+
+type playerAudit struct {
+	Name      string
+	Age       int
+	CreatedAt int64 `norm:"autoCreateTime"`
+	UpdatedAt int64 `norm:"autoUpdateTime"`
+}
+
+func (m playerAudit) VertexTagName() string {
+	return "player"
+}
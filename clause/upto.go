@@ -0,0 +1,31 @@
+package clause
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Upto clause, limits the max steps of the path
+type Upto struct {
+	Steps int
+}
+
+const UptoName = "UPTO"
+
+func (upto Upto) Name() string {
+	return UptoName
+}
+
+func (upto Upto) MergeIn(clause *Clause) {
+	clause.Expression = upto
+}
+
+func (upto Upto) Build(nGQL Builder) error {
+	if upto.Steps <= 0 {
+		return fmt.Errorf("norm: %w, upto steps must be positive", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("UPTO ")
+	nGQL.WriteString(strconv.Itoa(upto.Steps))
+	nGQL.WriteString(" STEPS")
+	return nil
+}
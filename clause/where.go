@@ -6,9 +6,12 @@ type Where struct {
 	Conditions []Condition
 }
 
+// Condition is a condition joined to the previous one by Operator, when Group is set, the grouped conditions are
+// written in parentheses instead of Expr
 type Condition struct {
 	Operator string
 	Expr     Expr
+	Group    []Condition
 }
 
 const (
@@ -45,7 +48,13 @@ func buildConditions(conditions []Condition, nGQL Builder) error {
 			nGQL.WriteByte(' ')
 		}
 		gql := strings.ToUpper(expr.Expr.Str)
-		if strings.Contains(gql, " AND ") || strings.Contains(gql, " OR ") || strings.Contains(gql, " NOT ") || strings.Contains(gql, " XOR ") {
+		if len(expr.Group) > 0 {
+			nGQL.WriteByte('(')
+			if err := buildConditions(expr.Group, nGQL); err != nil {
+				return err
+			}
+			nGQL.WriteByte(')')
+		} else if strings.Contains(gql, " AND ") || strings.Contains(gql, " OR ") || strings.Contains(gql, " NOT ") || strings.Contains(gql, " XOR ") {
 			nGQL.WriteByte('(')
 			if err := expr.Expr.Build(nGQL); err != nil {
 				return err
@@ -30,6 +30,16 @@ func TestWhere(t *testing.T) {
 			},
 			gqlWant: `WHERE properties(edge).degree > 90 OR properties($$).age != 33 AND properties($$).name != "Tony Parker"`,
 		},
+		{
+			clauses: []clause.Interface{
+				clause.Where{Conditions: []clause.Condition{{Operator: "AND", Group: []clause.Condition{
+					{Operator: "AND", Expr: clause.Expr{Str: "properties(edge).degree > ?", Vars: []any{90}}},
+					{Operator: "OR", Expr: clause.Expr{Str: "properties($$).age != ?", Vars: []any{33}}},
+				}}}},
+				clause.Where{Conditions: []clause.Condition{{Operator: "AND", Expr: clause.Expr{Str: "id($$) > ?", Vars: []any{"player100"}}}}},
+			},
+			gqlWant: `WHERE (properties(edge).degree > 90 OR properties($$).age != 33) AND id($$) > "player100"`,
+		},
 		{
 			clauses: []clause.Interface{
 				clause.Where{Conditions: []clause.Condition{{Operator: "AND", Expr: clause.Expr{Str: "exists(v.player.age)"}}}},
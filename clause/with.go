@@ -0,0 +1,49 @@
+package clause
+
+import "fmt"
+
+// With openCypher WITH clause
+type With struct {
+	Distinct bool
+	ExprList []string
+}
+
+const WithName = "WITH"
+
+func (w With) Name() string {
+	return WithName
+}
+
+func (w With) MergeIn(clause *Clause) {
+	exist, ok := clause.Expression.(With)
+	if !ok {
+		clause.Expression = w
+		return
+	}
+	exist.ExprList = append(exist.ExprList, w.ExprList...)
+	exist.Distinct = w.Distinct
+	clause.Expression = exist
+}
+
+func (w With) Build(nGQL Builder) error {
+	exprList := make([]string, 0, len(w.ExprList))
+	for _, expr := range w.ExprList {
+		if expr != "" {
+			exprList = append(exprList, expr)
+		}
+	}
+	if len(exprList) == 0 {
+		return fmt.Errorf("norm: %w, with expr is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("WITH ")
+	if w.Distinct {
+		nGQL.WriteString("DISTINCT ")
+	}
+	for i, expr := range exprList {
+		nGQL.WriteString(expr)
+		if i != len(exprList)-1 {
+			nGQL.WriteString(", ")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Command norm-gen generates the go structs of the tags and edges of an existing graph space.
+//
+//	norm-gen -addr 127.0.0.1:9669 -user root -password nebula -space basketball -pkg model -out model/schema.go
+//
+// The structs of all tags and edges are generated by default, -names restricts them to the listed ones.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/haysons/norm"
+	"github.com/haysons/norm/gen"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:9669", "addresses of the graph services, separated by commas")
+	user := flag.String("user", "root", "username")
+	password := flag.String("password", "nebula", "password")
+	space := flag.String("space", "", "name of the graph space")
+	pkg := flag.String("pkg", "model", "package name of the generated source")
+	out := flag.String("out", "", "file to write the generated source, stdout is used if empty")
+	names := flag.String("names", "", "names of the tags and edges to generate, separated by commas")
+	flag.Parse()
+	if *space == "" {
+		log.Fatal("norm-gen: -space is required")
+	}
+
+	db, err := norm.Open(&norm.Config{
+		Username:    *user,
+		Password:    *password,
+		SpaceName:   *space,
+		Addresses:   strings.Split(*addr, ","),
+		ConnTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	var schemaNames []string
+	if *names != "" {
+		schemaNames = strings.Split(*names, ",")
+	}
+	// log.Fatal exits without running the deferred functions, the sessions are released before exiting
+	err = run(db, schemaNames, *pkg, *out)
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run generates the source of the schemas and writes it to out
+func run(db *norm.DB, names []string, pkg, out string) error {
+	schema, err := gen.Load(db, names...)
+	if err != nil {
+		return err
+	}
+	src, err := gen.Generate(schema, pkg)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/haysons/norm/logger"
+	"github.com/haysons/norm/statement"
 	nebula "github.com/vesoft-inc/nebula-go/v3"
 )
 
@@ -42,6 +43,14 @@ type Config struct {
 
 	timezone *time.Location
 
+	parameterized bool
+
+	retryPolicy *RetryPolicy
+
+	softDeleteModels []any
+
+	softDeletes statement.SoftDeletes
+
 	logger logger.Interface
 }
 
@@ -62,9 +71,37 @@ func WithNebulaSessionPoolOptions(opts []nebula.SessionPoolConfOption) ConfigOpt
 	})
 }
 
+// WithParameterizedQuery sends the arguments of Where, When and Raw to the server as query parameters
+// instead of writing them into the statement as literals, see statement.Statement.Parameterized
+func WithParameterizedQuery() ConfigOption {
+	return funcConfigOption(func(config *Config) {
+		config.parameterized = true
+	})
+}
+
+// WithRetryPolicy customizes the retry policy of the transient failures, DefaultRetryPolicy is used by default,
+// and retrying can be disabled by setting MaxAttempts to 1
+func WithRetryPolicy(policy RetryPolicy) ConfigOption {
+	return funcConfigOption(func(config *Config) {
+		config.retryPolicy = &policy
+	})
+}
+
 // WithLogger customizes the logger used by norm
 func WithLogger(logger logger.Interface) ConfigOption {
 	return funcConfigOption(func(config *Config) {
 		config.logger = logger
 	})
 }
+
+// WithSoftDelete registers the vertices and edges whose tags and edges declare a prop with the soft_delete setting,
+// such as `norm:"prop:deleted_at;soft_delete"`. The LOOKUP, GO and FETCH statements on them filter out the soft deleted
+// data unless DB.Unscoped is called, and DeleteEdge on them sets the prop instead of deleting the edges unless
+// DB.HardDelete is called. DeleteVertex sets the props when the vertices are passed as structs, while the vertices
+// passed as vids are deleted since their tags are unknown, except that G[T] soft deletes them by the tags of T, see
+// statement.Statement.SoftDeletes.
+func WithSoftDelete(models ...any) ConfigOption {
+	return funcConfigOption(func(config *Config) {
+		config.softDeleteModels = append(config.softDeleteModels, models...)
+	})
+}
@@ -2,9 +2,13 @@ package norm
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/haysons/norm/clause"
 	"github.com/haysons/norm/resolver"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
 )
 
 var (
@@ -19,4 +23,95 @@ var (
 
 	// ErrInvalidClauseParams usually because the arguments to the build clause are anomalous, causing the build to fail
 	ErrInvalidClauseParams = clause.ErrInvalidClauseParams
+
+	// ErrJobFailed the job running in the background of nebula graph failed or was stopped, eg: rebuilding indexes
+	ErrJobFailed = errors.New("job failed")
+
+	// ErrMigrationLocked the versioned migrations are being run by another deployer
+	ErrMigrationLocked = errors.New("migration locked")
+)
+
+// The following errors classify the failed results returned by nebula graph, the *NebulaError returned by the
+// execution methods can be checked against them with errors.Is
+var (
+	// ErrTagNotFound the tag does not exist in the graph space
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrEdgeNotFound the edge type does not exist in the graph space
+	ErrEdgeNotFound = errors.New("edge not found")
+
+	// ErrIndexNotFound the index does not exist in the graph space
+	ErrIndexNotFound = errors.New("index not found")
+
+	// ErrSpaceNotFound the graph space does not exist
+	ErrSpaceNotFound = errors.New("space not found")
+
+	// ErrSyntax the statement has a syntax error
+	ErrSyntax = errors.New("syntax error")
+
+	// ErrExisted the schema or data to be created already exists
+	ErrExisted = errors.New("existed")
+
+	// ErrSessionInvalid the session used to execute the statement is invalid or expired
+	ErrSessionInvalid = errors.New("session invalid")
 )
+
+// nebulaErrorKinds maps the error codes and the prefixes of the error messages to the errors above, the graph service
+// usually reports a missing schema as E_EXECUTION_ERROR or E_SEMANTIC_ERROR with a message such as
+// "TagNotFound: Tag not existed!", so both are checked.
+var nebulaErrorKinds = []struct {
+	err       error
+	codes     []nebula.ErrorCode
+	msgPrefix string
+}{
+	{err: ErrTagNotFound, codes: []nebula.ErrorCode{nebula.ErrorCode(nebulattypes.ErrorCode_E_TAG_NOT_FOUND)}, msgPrefix: "TagNotFound"},
+	{err: ErrEdgeNotFound, codes: []nebula.ErrorCode{nebula.ErrorCode(nebulattypes.ErrorCode_E_EDGE_NOT_FOUND)}, msgPrefix: "EdgeNotFound"},
+	{err: ErrIndexNotFound, codes: []nebula.ErrorCode{nebula.ErrorCode(nebulattypes.ErrorCode_E_INDEX_NOT_FOUND)}, msgPrefix: "IndexNotFound"},
+	{err: ErrSpaceNotFound, codes: []nebula.ErrorCode{nebula.ErrorCode(nebulattypes.ErrorCode_E_SPACE_NOT_FOUND)}, msgPrefix: "SpaceNotFound"},
+	{err: ErrSyntax, codes: []nebula.ErrorCode{nebula.ErrorCode_E_SYNTAX_ERROR}, msgPrefix: "SyntaxError"},
+	{err: ErrExisted, codes: []nebula.ErrorCode{nebula.ErrorCode(nebulattypes.ErrorCode_E_EXISTED)}, msgPrefix: "Existed"},
+	{err: ErrSessionInvalid, codes: []nebula.ErrorCode{nebula.ErrorCode_E_SESSION_INVALID, nebula.ErrorCode_E_SESSION_TIMEOUT}},
+}
+
+// NebulaError is returned when nebula graph fails to execute a statement, it carries the error code and message
+// reported by the server, along with the statement and the graph space.
+type NebulaError struct {
+	Code      nebula.ErrorCode
+	Msg       string
+	NGQL      string
+	SpaceName string
+}
+
+// newNebulaError creates the error of the failed result, the space name of a failed result is usually empty, in which
+// case the given space the statement was executed in is used
+func newNebulaError(res *nebula.ResultSet, nGQL string, spaceName string) *NebulaError {
+	if name := res.GetSpaceName(); name != "" {
+		spaceName = name
+	}
+	return &NebulaError{
+		Code:      res.GetErrorCode(),
+		Msg:       res.GetErrorMsg(),
+		NGQL:      nGQL,
+		SpaceName: spaceName,
+	}
+}
+
+func (e *NebulaError) Error() string {
+	return fmt.Sprintf("norm: result is not succeed, err code: %d, msg: %s", e.Code, e.Msg)
+}
+
+// Is reports whether the error matches one of the errors classifying the failed results, such as ErrTagNotFound
+func (e *NebulaError) Is(target error) bool {
+	for _, kind := range nebulaErrorKinds {
+		if kind.err != target {
+			continue
+		}
+		for _, code := range kind.codes {
+			if e.Code == code {
+				return true
+			}
+		}
+		return kind.msgPrefix != "" && strings.HasPrefix(e.Msg, kind.msgPrefix)
+	}
+	return false
+}
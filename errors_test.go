@@ -0,0 +1,67 @@
+package norm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
+)
+
+func TestNebulaErrorIs(t *testing.T) {
+	tests := []struct {
+		err    *NebulaError
+		target error
+		want   bool
+	}{
+		{err: &NebulaError{Code: nebula.ErrorCode(nebulattypes.ErrorCode_E_TAG_NOT_FOUND)}, target: ErrTagNotFound, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_SEMANTIC_ERROR, Msg: "TagNotFound: Tag not existed!"}, target: ErrTagNotFound, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_EXECUTION_ERROR, Msg: "EdgeNotFound: Edge not existed!"}, target: ErrEdgeNotFound, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_EXECUTION_ERROR, Msg: "EdgeNotFound: Edge not existed!"}, target: ErrTagNotFound, want: false},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_EXECUTION_ERROR, Msg: "IndexNotFound: Index not existed!"}, target: ErrIndexNotFound, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode(nebulattypes.ErrorCode_E_SPACE_NOT_FOUND)}, target: ErrSpaceNotFound, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_SYNTAX_ERROR, Msg: "syntax error near `VERTX'"}, target: ErrSyntax, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_EXECUTION_ERROR, Msg: "Existed!"}, target: ErrExisted, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_SESSION_TIMEOUT}, target: ErrSessionInvalid, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_SESSION_INVALID}, target: ErrSessionInvalid, want: true},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_EXECUTION_ERROR, Msg: "SessionInvalid"}, target: ErrSessionInvalid, want: false},
+		{err: &NebulaError{Code: nebula.ErrorCode_E_EXECUTION_ERROR, Msg: "Storage Error: TagNotFound"}, target: ErrTagNotFound, want: false},
+		{err: &NebulaError{Code: nebula.ErrorCode(nebulattypes.ErrorCode_E_TAG_NOT_FOUND)}, target: ErrRecordNotFound, want: false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, errors.Is(fmt.Errorf("find: %w", tt.err), tt.target))
+		})
+	}
+}
+
+func TestNewNebulaError(t *testing.T) {
+	tests := []struct {
+		resSpace  string
+		spaceName string
+		want      string
+	}{
+		{resSpace: "", spaceName: "basketball", want: "basketball"},
+		{resSpace: "football", spaceName: "basketball", want: "football"},
+		{resSpace: "", spaceName: "", want: ""},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			res, err := nebula.GenResultSet(&graph.ExecutionResponse{
+				ErrorCode: nebulattypes.ErrorCode_E_EXECUTION_ERROR,
+				ErrorMsg:  []byte("TagNotFound: Tag not existed!"),
+				SpaceName: []byte(tt.resSpace),
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			nebulaErr := newNebulaError(res, "FETCH PROP ON player \"player100\" YIELD properties(vertex)", tt.spaceName)
+			assert.Equal(t, tt.want, nebulaErr.SpaceName)
+			assert.Equal(t, nebula.ErrorCode_E_EXECUTION_ERROR, nebulaErr.Code)
+			assert.ErrorIs(t, nebulaErr, ErrTagNotFound)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/haysons/norm"
+)
+
+type Player struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+	Age  int    `norm:"prop:age"`
+}
+
+func (p Player) VertexID() string {
+	return p.VID
+}
+
+func (p Player) VertexTagName() string {
+	return "player"
+}
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	conf := &norm.Config{
+		Username:    "root",
+		Password:    "nebula",
+		SpaceName:   "demo_basketballplayer",
+		Addresses:   []string{"127.0.0.1:9669"},
+		ConnTimeout: 10 * time.Second,
+	}
+	db, err := norm.Open(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	// Callbacks registered before norm.CallbackBuild can read and change the statement before it is built,
+	// returning an error aborts the execution, here deleting is forbidden in read-only mode.
+	readOnly := true
+	err = db.Callback().Delete().Before(norm.CallbackBuild).Register("read_only", func(db *norm.DB) error {
+		if readOnly {
+			return errors.New("deleting is not allowed in read-only mode")
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Callbacks registered after norm.CallbackExecute can read the built statement and the result set,
+	// which is useful for auditing.
+	err = db.Callback().Insert().After(norm.CallbackExecute).Register("audit", func(db *norm.DB) error {
+		nGQL, _ := db.Statement.NGQL()
+		log.Printf("audit: %s, latency: %dus", nGQL, db.ResultSet().GetLatency())
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	player := &Player{
+		VID:  "player1001",
+		Name: "Kobe Bryant",
+		Age:  33,
+	}
+	if err = db.InsertVertex(player).Exec(); err != nil {
+		log.Fatalf("insert player failed: %v", err)
+	}
+
+	if err = db.DeleteVertex("player1001").Exec(); err != nil {
+		log.Printf("delete player failed: %v", err)
+	}
+}
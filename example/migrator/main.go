@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/haysons/norm"
+	"github.com/haysons/norm/clause"
 )
 
 var db *norm.DB
@@ -25,9 +27,41 @@ func main() {
 	}
 	defer db.Close()
 
+	migrateSpaces()
+
 	migrateTags()
 
 	migrateEdges()
+
+	migrateVersioned()
+}
+
+func migrateSpaces() {
+	migrator := db.Debug().Migrator()
+	// the space is polled until it is ready to use, which may take about 10 seconds
+	err := migrator.CreateSpace("test_clone_source", "FIXED_STRING(32)",
+		clause.WithPartitionNum(10), clause.WithReplicaFactor(1), clause.WithIfNotExists())
+	if err != nil {
+		log.Fatal(err)
+	}
+	space, err := migrator.DescSpace("test_clone_source")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("space: %+v", space)
+	if err = migrator.CloneSpace("test_clone", "test_clone_source", true); err != nil {
+		log.Fatal(err)
+	}
+	hasSpace, err := migrator.HasSpace("test_clone")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("has space: %v", hasSpace)
+	for _, spaceName := range []string{"test_clone", "test_clone_source"} {
+		if err = migrator.DropSpace(spaceName, true); err != nil {
+			log.Fatal(err)
+		}
+	}
 }
 
 type Woman struct {
@@ -80,6 +114,19 @@ func migrateTags() {
 		log.Printf("woman prop: %+v\n", womanProp)
 	}
 
+	// the created indexes can be rebuilt after they are visible to the graph service, which takes a heartbeat
+	time.Sleep(10 * time.Second)
+	job, err := migrator.RebuildVertexTagIndexes("idx_woman_name", "idx_woman_age_married")
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err = job.Wait(ctx); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("rebuild index job %d finished", job.ID)
+
 	if err = migrator.DropVertexTagIndex("idx_woman_name", true); err != nil {
 		log.Fatal(err)
 	}
@@ -88,7 +135,16 @@ func migrateTags() {
 	}
 
 	migrator = norm.NewMigrator(db.Debug())
-	if err = migrator.AutoMigrateVertexes(WomanUpdate{}); err != nil {
+	plan, err := migrator.PlanVertexes(WomanUpdate{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, diff := range plan.Diffs {
+		log.Printf("%s %s: added %v, changed %d, orphaned %v, missing indexes %v\n",
+			diff.Kind, diff.Name, diff.AddedProps, len(diff.ChangedProps), diff.OrphanedProps, diff.MissingIndexes)
+	}
+	log.Printf("planned nGQL:\n%s", plan)
+	if err = migrator.Apply(plan); err != nil {
 		log.Fatal(err)
 	}
 	womanProps, err = migrator.DescVertexTag("woman")
@@ -165,3 +221,30 @@ func migrateEdges() {
 		log.Fatal(err)
 	}
 }
+
+func migrateVersioned() {
+	vm := db.Debug().Migrator().Versioned(
+		&norm.Migration{
+			ID: "20240101_add_woman_alice",
+			Up: func(db *norm.DB) error {
+				return db.InsertVertex(&WomanUpdate{VID: "w101", Name: "alice", Age: 22}).Exec()
+			},
+			Down: func(db *norm.DB) error {
+				return db.DeleteVertex("w101").Exec()
+			},
+		},
+	)
+	if err := vm.Migrate(); err != nil {
+		log.Fatal(err)
+	}
+	statuses, err := vm.Status()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, status := range statuses {
+		log.Printf("migration %s applied: %t at %s\n", status.ID, status.Applied, status.AppliedAt)
+	}
+	if err = vm.Rollback(1); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"log"
+	"time"
 
 	"github.com/haysons/norm"
 	"github.com/haysons/norm/clause"
@@ -34,6 +36,20 @@ func fetch() {
 	}
 	log.Printf("player: %+v", player)
 
+	// The context passed by WithContext reaches the logger, and the query returns ctx.Err() as soon as the
+	// context is canceled or its deadline is exceeded.
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	player = new(Player)
+	err = db.WithContext(ctx).
+		Fetch("player", "player100").
+		Yield("vertex as v").
+		TakeCol("v", player)
+	if err != nil && !errors.Is(err, norm.ErrRecordNotFound) {
+		log.Fatal(err)
+	}
+	log.Printf("player: %+v", player)
+
 	// FETCH PROP ON player "player100" \
 	// YIELD properties(vertex).name AS name;
 	// Get only the name attribute, which can be assigned directly to a string variable.
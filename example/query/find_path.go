@@ -0,0 +1,51 @@
+package main
+
+import (
+	"log"
+
+	"github.com/haysons/norm"
+	"github.com/haysons/norm/clause"
+)
+
+func findPath() {
+	// FIND SHORTEST PATH WITH PROP FROM "player102" TO "player100" OVER follow \
+	// YIELD path AS p;
+	// The nodes and relationships of the path are assigned to the vertex and edge structs through norm.Path.
+	paths := make([]norm.Path[Player, Follow], 0)
+	err := db.FindPath(clause.PathTypeShortest, true).
+		From("player102").
+		To("player100").
+		Over("follow").
+		Yield("path AS p").
+		FindCol("p", &paths)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, path := range paths {
+		log.Printf("shortest path nodes: %+v, relationships: %+v", path.Nodes, path.Relationships)
+	}
+
+	// FIND ALL PATH FROM "player100" TO "team204" OVER * \
+	// WHERE serve.start_year > 1990 OR follow.degree > 90 UPTO 3 STEPS \
+	// YIELD path AS p | LIMIT 5;
+	// If the path contains vertexes or edges of different types, any can be used as the element type.
+	type record struct {
+		P norm.Path[any, any] `norm:"col:p"`
+	}
+	records := make([]*record, 0)
+	err = db.FindPath(clause.PathTypeAll).
+		From("player100").
+		To("team204").
+		Over("*").
+		Where("serve.start_year > ? OR follow.degree > ?", 1990, 90).
+		Upto(3).
+		Yield("path AS p").
+		Limit(5).
+		Find(&records)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, r := range records {
+		log.Printf("path length: %d", len(r.P.Relationships))
+	}
+}
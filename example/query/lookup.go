@@ -35,6 +35,21 @@ func lookup() {
 	}
 	log.Printf("Steve Nash vid: %v", vid)
 
+	// LOOKUP ON player \
+	// WHERE player.name == $p1 \
+	// YIELD id(vertex);
+	// In parameterized mode, the arguments are sent to the server as query parameters instead of being written into
+	// the statement. The mode can also be enabled for every statement with the norm.WithParameterizedQuery option.
+	err = db.Parameterized().
+		Lookup("player").
+		Where("player.name == ?", "Steve Nash").
+		Yield("id(vertex) as vid").
+		FindCol("vid", &vid)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Steve Nash vid: %v", vid)
+
 	// LOOKUP ON player \
 	// WHERE player.name == "Tony Parker" \
 	// YIELD properties(vertex).name AS name, properties(vertex).age AS age;
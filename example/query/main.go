@@ -106,4 +106,6 @@ func main() {
 	lookup()
 	queryGo()
 	fetch()
+	match()
+	findPath()
 }
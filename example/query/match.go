@@ -0,0 +1,60 @@
+package main
+
+import (
+	"log"
+
+	"github.com/haysons/norm/clause"
+)
+
+func match() {
+	// MATCH (v:player{name: "Tim Duncan"})-[e:follow]->(v2:player) \
+	// RETURN v2;
+	// Node and relationship patterns can be built from the vertex and edge structs, the tag name, edge type name and
+	// the non-zero properties of the struct are used as the filter of the pattern.
+	players := make([]*Player, 0)
+	err := db.Match(clause.Node("v", &Player{Name: "Tim Duncan"}).Out("e", Follow{}).Node("v2", Player{})).
+		Return("v2").
+		FindCol("v2", &players)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, player := range players {
+		log.Printf("Tim Duncan follow: %+v", player)
+	}
+
+	// MATCH (v:player)-[e:serve]->(t:team) \
+	// WHERE v.player.age > 40 \
+	// RETURN v, e, t ORDER BY v.player.age DESC LIMIT 3;
+	// vertexes and edges in the result can be assigned to the struct fields directly.
+	type record struct {
+		V Player `norm:"col:v"`
+		E Serve  `norm:"col:e"`
+		T Team   `norm:"col:t"`
+	}
+	records := make([]*record, 0)
+	err = db.Match(clause.Node("v", Player{}).Out("e", Serve{}).Node("t", Team{})).
+		Where("v.player.age > ?", 40).
+		Return("v, e, t").
+		OrderBy("v.player.age DESC").
+		Limit(3).
+		Find(&records)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, r := range records {
+		log.Printf("player: %+v, serve: %+v, team: %+v", r.V, r.E, r.T)
+	}
+
+	// MATCH (v:player) WITH v ORDER BY v.player.age DESC LIMIT 1 \
+	// MATCH (v)-[e:follow*1..2]->(v2:player) RETURN DISTINCT v2.player.name AS name;
+	names := make([]string, 0)
+	err = db.Match(clause.Node("v", Player{})).
+		With("v").OrderBy("v.player.age DESC").Limit(1).
+		Match(clause.Node("v").Out("e", Follow{}).Hops(1, 2).Node("v2", Player{})).
+		Return("v2.player.name AS name", true).
+		FindCol("name", &names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("followed by the oldest player within 2 steps: %v", names)
+}
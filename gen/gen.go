@@ -0,0 +1,306 @@
+// Package gen generates the go structs of the tags and edges of an existing graph space, the generated structs carry
+// the norm tags expected by resolver.ParseVertex and resolver.ParseEdge, so that they can be used to query the space
+// and to migrate it again.
+//
+//	schema, err := gen.Load(db)
+//	if err != nil {
+//		return err
+//	}
+//	src, err := gen.Generate(schema, "model")
+//	if err != nil {
+//		return err
+//	}
+//	err = os.WriteFile("model/schema.go", src, 0o644)
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/haysons/norm"
+)
+
+// Schema is the schema of a graph space read by Load
+type Schema struct {
+	// VIDType is the vid type of the space, such as FIXED_STRING(32) or INT64
+	VIDType string
+	Tags    []*Definition
+	Edges   []*Definition
+}
+
+// Definition is the definition of a tag or edge
+type Definition struct {
+	Name  string
+	Props []*norm.PropDesc
+	// TTLCol and TTLDuration are empty if the schema has no ttl
+	TTLCol      string
+	TTLDuration string
+	Indexes     []*Index
+}
+
+// Index is an index on the tag or edge, the fields are in the order they are indexed
+type Index struct {
+	Name   string
+	Fields []*IndexField
+}
+
+// IndexField is a prop of the index, Length is the index length of the string props
+type IndexField struct {
+	Prop   string
+	Length int
+}
+
+// Generate renders the go source of the structs of the schema, one struct for each tag and edge. The vertex structs
+// implement VertexID and VertexTagName, the edge structs implement EdgeTypeName.
+func Generate(schema *Schema, pkg string) ([]byte, error) {
+	vidType := "string"
+	if strings.EqualFold(schema.VIDType, "INT64") {
+		vidType = "int64"
+	}
+	g := &generator{imports: make(map[string]bool)}
+	for _, tag := range schema.Tags {
+		g.writeTag(tag, vidType)
+	}
+	for _, edge := range schema.Edges {
+		g.writeEdge(edge, vidType)
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by norm-gen. DO NOT EDIT.\n\n")
+	src.WriteString("package " + pkg + "\n\n")
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			imports = append(imports, path)
+		}
+		// the standard library is imported first, separated from the others by a blank line
+		sort.Slice(imports, func(i, j int) bool {
+			iStd, jStd := !strings.Contains(imports[i], "."), !strings.Contains(imports[j], ".")
+			if iStd != jStd {
+				return iStd
+			}
+			return imports[i] < imports[j]
+		})
+		src.WriteString("import (\n")
+		for i, path := range imports {
+			if i > 0 && !strings.Contains(imports[i-1], ".") && strings.Contains(path, ".") {
+				src.WriteByte('\n')
+			}
+			src.WriteString(strconv.Quote(path) + "\n")
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(g.body.Bytes())
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("norm: format generated source failed, %w", err)
+	}
+	return formatted, nil
+}
+
+type generator struct {
+	body    bytes.Buffer
+	imports map[string]bool
+}
+
+func (g *generator) writeTag(tag *Definition, vidType string) {
+	name := goName(tag.Name)
+	fmt.Fprintf(&g.body, "// %s is the vertex of tag %s\n", name, tag.Name)
+	fmt.Fprintf(&g.body, "type %s struct {\n", name)
+	fmt.Fprintf(&g.body, "VID %s `norm:\"vertex_id\"`\n", vidType)
+	g.writeProps(tag, "VID")
+	g.body.WriteString("}\n\n")
+	fmt.Fprintf(&g.body, "func (t %s) VertexID() %s {\nreturn t.VID\n}\n\n", name, vidType)
+	fmt.Fprintf(&g.body, "func (t %s) VertexTagName() string {\nreturn %s\n}\n\n", name, strconv.Quote(tag.Name))
+}
+
+func (g *generator) writeEdge(edge *Definition, vidType string) {
+	name := goName(edge.Name)
+	fmt.Fprintf(&g.body, "// %s is the edge of type %s\n", name, edge.Name)
+	fmt.Fprintf(&g.body, "type %s struct {\n", name)
+	fmt.Fprintf(&g.body, "SrcID %s `norm:\"edge_src_id\"`\n", vidType)
+	fmt.Fprintf(&g.body, "DstID %s `norm:\"edge_dst_id\"`\n", vidType)
+	g.body.WriteString("Rank int64 `norm:\"edge_rank\"`\n")
+	g.writeProps(edge, "SrcID", "DstID", "Rank")
+	g.body.WriteString("}\n\n")
+	fmt.Fprintf(&g.body, "func (e %s) EdgeTypeName() string {\nreturn %s\n}\n\n", name, strconv.Quote(edge.Name))
+}
+
+// writeProps writes the fields of the props, the fields are renamed with a Prop suffix if they clash with the
+// reserved fields, since the prop names are always declared in the norm tag, the field names do not matter
+func (g *generator) writeProps(def *Definition, reserved ...string) {
+	indexSettings, skippedIndexes := propIndexSettings(def.Indexes)
+	fieldNames := make(map[string]bool)
+	for _, name := range reserved {
+		fieldNames[name] = true
+	}
+	for _, prop := range def.Props {
+		fieldName := goName(prop.Field)
+		for fieldNames[fieldName] {
+			fieldName += "Prop"
+		}
+		fieldNames[fieldName] = true
+		goType, dataType := g.goType(prop.Type)
+		settings := []string{"prop:" + prop.Field}
+		if dataType != "" {
+			settings = append(settings, "type:"+dataType)
+		}
+		if strings.EqualFold(prop.Null, "NO") {
+			settings = append(settings, "not_null")
+		}
+		if value, ok := defaultValue(prop); ok {
+			settings = append(settings, "default:"+value)
+		}
+		if comment := sanitize(prop.Comment); comment != "" && comment != "_EMPTY_" {
+			settings = append(settings, "comment:"+comment)
+		}
+		if prop.Field == def.TTLCol && def.TTLDuration != "" {
+			settings = append(settings, "ttl:"+def.TTLDuration)
+		}
+		if index, ok := indexSettings[prop.Field]; ok {
+			settings = append(settings, "index:"+index)
+		}
+		fmt.Fprintf(&g.body, "%s %s `norm:%s`", fieldName, goType, strconv.Quote(strings.Join(settings, ";")))
+		if prop.Comment != "" && prop.Comment != "_EMPTY_" {
+			g.body.WriteString(" // " + strings.ReplaceAll(prop.Comment, "\n", " "))
+		}
+		g.body.WriteByte('\n')
+	}
+	for _, index := range skippedIndexes {
+		fmt.Fprintf(&g.body, "// index %s is not generated, a prop can only be declared in one index\n", index)
+	}
+}
+
+// goType returns the go type of the nebula graph data type, and the data type to be declared in the norm tag if it
+// differs from the one derived from the go type
+func (g *generator) goType(dataType string) (string, string) {
+	lower := strings.ToLower(dataType)
+	switch {
+	case lower == "bool":
+		return "bool", ""
+	case lower == "int64", lower == "int32", lower == "int16", lower == "int8":
+		return lower, ""
+	case lower == "int":
+		return "int64", ""
+	case lower == "float":
+		return "float32", ""
+	case lower == "double":
+		return "float64", ""
+	case lower == "string":
+		return "string", ""
+	case strings.HasPrefix(lower, "fixed_string"):
+		return "string", lower
+	case lower == "datetime":
+		g.imports["time"] = true
+		return "time.Time", ""
+	case lower == "date", lower == "time":
+		g.imports["time"] = true
+		return "time.Time", lower
+	case lower == "timestamp":
+		return "int64", lower
+	case lower == "duration":
+		g.imports["github.com/haysons/norm/resolver"] = true
+		return "resolver.Duration", ""
+	case lower == "geography(point)":
+		g.imports["github.com/haysons/norm/resolver"] = true
+		return "resolver.Point", ""
+	case lower == "geography(linestring)":
+		g.imports["github.com/haysons/norm/resolver"] = true
+		return "resolver.LineString", ""
+	case lower == "geography(polygon)":
+		g.imports["github.com/haysons/norm/resolver"] = true
+		return "resolver.Polygon", ""
+	case strings.HasPrefix(lower, "geography"):
+		// the geography of any shape is scanned into a string as well-known text
+		return "string", lower
+	}
+	return "any", lower
+}
+
+// propIndexSettings returns the index setting of each indexed prop, since a field can only declare one index, the
+// indexes whose props are already declared in another index are skipped
+func propIndexSettings(indexes []*Index) (map[string]string, []string) {
+	settings := make(map[string]string)
+	skipped := make([]string, 0)
+	for _, index := range indexes {
+		conflict := len(index.Fields) == 0
+		for _, field := range index.Fields {
+			if _, ok := settings[field.Prop]; ok {
+				conflict = true
+			}
+		}
+		if conflict {
+			skipped = append(skipped, index.Name)
+			continue
+		}
+		for i, field := range index.Fields {
+			setting := index.Name
+			if len(index.Fields) > 1 {
+				setting += ",priority:" + strconv.Itoa(i+1)
+			}
+			if field.Length > 0 {
+				setting += ",length:" + strconv.Itoa(field.Length)
+			}
+			settings[field.Prop] = setting
+		}
+	}
+	return settings, skipped
+}
+
+// defaultValue converts the default value returned by DESCRIBE into the one expected by the norm tag, the quoted
+// strings are unquoted, and the empty string is written as ” like in the hand-written structs, both are quoted again
+// by resolver.Prop.DefaultNGQL for the string and fixed_string props
+func defaultValue(prop *norm.PropDesc) (string, bool) {
+	value := prop.Default
+	switch value {
+	case "_EMPTY_":
+		return "", false
+	case "":
+		return "''", true
+	}
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+		if value == "" {
+			return "''", true
+		}
+	}
+	// the settings of the norm tag are separated by semicolons, which cannot be escaped
+	if strings.Contains(value, ";") {
+		return "", false
+	}
+	return value, true
+}
+
+// sanitize removes the characters that cannot appear in a setting of the norm tag
+func sanitize(s string) string {
+	return strings.NewReplacer(";", ",", "\n", " ", "`", "'").Replace(s)
+}
+
+// goName converts the snake case name into an exported go identifier, such as player_info to PlayerInfo
+func goName(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if sb.Len() == 0 && unicode.IsDigit(r) {
+			sb.WriteByte('X')
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	if sb.Len() == 0 {
+		return "X"
+	}
+	return sb.String()
+}
@@ -0,0 +1,178 @@
+package gen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/haysons/norm"
+	"github.com/haysons/norm/resolver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	schema := &Schema{
+		VIDType: "FIXED_STRING(32)",
+		Tags: []*Definition{
+			{
+				Name: "player",
+				Props: []*norm.PropDesc{
+					{Field: "name", Type: "string", Null: "NO", Default: "_EMPTY_", Comment: "player name"},
+					{Field: "age", Type: "int64", Null: "YES", Default: "18", Comment: "_EMPTY_"},
+					{Field: "nickname", Type: "fixed_string(16)", Null: "YES", Default: "", Comment: "_EMPTY_"},
+					{Field: "birthday", Type: "date", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+					{Field: "created_at", Type: "datetime", Null: "YES", Default: "datetime()", Comment: "_EMPTY_"},
+					{Field: "location", Type: "geography(point)", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+				},
+				TTLCol:      "created_at",
+				TTLDuration: "100",
+				Indexes: []*Index{
+					{Name: "idx_player_name_age", Fields: []*IndexField{{Prop: "name", Length: 10}, {Prop: "age"}}},
+					{Name: "idx_player_age", Fields: []*IndexField{{Prop: "age"}}},
+				},
+			},
+		},
+		Edges: []*Definition{
+			{
+				Name: "follow",
+				Props: []*norm.PropDesc{
+					{Field: "degree", Type: "int64", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+					{Field: "rank", Type: "double", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+				},
+			},
+		},
+	}
+	src, err := Generate(schema, "model")
+	if !assert.NoError(t, err) {
+		return
+	}
+	want := "// Code generated by norm-gen. DO NOT EDIT.\n\n" +
+		"package model\n\n" +
+		"import (\n" +
+		"\t\"time\"\n\n" +
+		"\t\"github.com/haysons/norm/resolver\"\n" +
+		")\n\n" +
+		"// Player is the vertex of tag player\n" +
+		"type Player struct {\n" +
+		"\tVID       string         `norm:\"vertex_id\"`\n" +
+		"\tName      string         `norm:\"prop:name;not_null;comment:player name;index:idx_player_name_age,priority:1,length:10\"` // player name\n" +
+		"\tAge       int64          `norm:\"prop:age;default:18;index:idx_player_name_age,priority:2\"`\n" +
+		"\tNickname  string         `norm:\"prop:nickname;type:fixed_string(16);default:''\"`\n" +
+		"\tBirthday  time.Time      `norm:\"prop:birthday;type:date\"`\n" +
+		"\tCreatedAt time.Time      `norm:\"prop:created_at;default:datetime();ttl:100\"`\n" +
+		"\tLocation  resolver.Point `norm:\"prop:location\"`\n" +
+		"\t// index idx_player_age is not generated, a prop can only be declared in one index\n" +
+		"}\n\n" +
+		"func (t Player) VertexID() string {\n\treturn t.VID\n}\n\n" +
+		"func (t Player) VertexTagName() string {\n\treturn \"player\"\n}\n\n" +
+		"// Follow is the edge of type follow\n" +
+		"type Follow struct {\n" +
+		"\tSrcID    string  `norm:\"edge_src_id\"`\n" +
+		"\tDstID    string  `norm:\"edge_dst_id\"`\n" +
+		"\tRank     int64   `norm:\"edge_rank\"`\n" +
+		"\tDegree   int64   `norm:\"prop:degree\"`\n" +
+		"\tRankProp float64 `norm:\"prop:rank\"`\n" +
+		"}\n\n" +
+		"func (e Follow) EdgeTypeName() string {\n\treturn \"follow\"\n}\n"
+	assert.Equal(t, want, string(src))
+}
+
+func TestGenerateIntVID(t *testing.T) {
+	schema := &Schema{
+		VIDType: "INT64",
+		Tags:    []*Definition{{Name: "team_info", Props: []*norm.PropDesc{{Field: "name", Type: "string", Null: "YES", Default: "\"none\""}}}},
+	}
+	src, err := Generate(schema, "model")
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(src), "VID  int64  `norm:\"vertex_id\"`")
+		assert.Contains(t, string(src), "Name string `norm:\"prop:name;default:none\"`")
+		assert.Contains(t, string(src), "func (t TeamInfo) VertexID() int64 {")
+	}
+}
+
+func TestGenerateRoundTrip(t *testing.T) {
+	props := []*norm.PropDesc{
+		{Field: "name", Type: "string", Null: "NO", Default: "\"none\"", Comment: "_EMPTY_"},
+		{Field: "nickname", Type: "fixed_string(16)", Null: "YES", Default: "\"no\\\"ne\"", Comment: "_EMPTY_"},
+		{Field: "alias", Type: "fixed_string(16)", Null: "YES", Default: "", Comment: "_EMPTY_"},
+		{Field: "team", Type: "string", Null: "YES", Default: "_EMPTY_", Comment: "team of player"},
+		{Field: "age", Type: "int64", Null: "YES", Default: "18", Comment: "_EMPTY_"},
+		{Field: "created_at", Type: "datetime", Null: "YES", Default: "datetime()", Comment: "_EMPTY_"},
+	}
+	src, err := Generate(&Schema{VIDType: "INT64", Tags: []*Definition{{Name: "player", Props: props}}}, "model")
+	if !assert.NoError(t, err) {
+		return
+	}
+	fields := generatedFields(t, src)
+	if !assert.Len(t, fields, len(props)+1) {
+		return
+	}
+	// the props of the generated fields are compared with the described ones like the migration plan does, so that
+	// planning the generated structs against the graph space they are generated from changes nothing
+	for i, desc := range props {
+		field := fields[i+1]
+		prop := &resolver.Prop{
+			Name:     resolver.GetPropName(field),
+			DataType: resolver.GetFieldDataType(field),
+			NotNull:  resolver.IsFieldNotNull(field),
+			Default:  resolver.GetFieldDefault(field),
+			Comment:  resolver.GetFieldComment(field),
+		}
+		descDefault := ""
+		if desc.Default != "_EMPTY_" {
+			descDefault = desc.Default
+			if descDefault == "" {
+				descDefault = "''"
+			}
+		}
+		assert.Equal(t, desc.Field, prop.Name)
+		assert.Equal(t, desc.Type, prop.DataType, desc.Field)
+		assert.Equal(t, desc.Null == "NO", prop.NotNull, desc.Field)
+		assert.Equal(t, (&resolver.Prop{DataType: desc.Type, Default: descDefault}).DefaultNGQL(), prop.DefaultNGQL(), desc.Field)
+		assert.Equal(t, strings.TrimPrefix(desc.Comment, "_EMPTY_"), prop.Comment, desc.Field)
+	}
+}
+
+// generatedFields parses the generated source and returns the fields of the first struct with their go types
+func generatedFields(t *testing.T, src []byte) []reflect.StructField {
+	goTypes := map[string]reflect.Type{
+		"string":    reflect.TypeOf(""),
+		"int64":     reflect.TypeOf(int64(0)),
+		"time.Time": reflect.TypeOf(time.Time{}),
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields []reflect.StructField
+	ast.Inspect(file, func(node ast.Node) bool {
+		structType, ok := node.(*ast.StructType)
+		if !ok || fields != nil {
+			return fields == nil
+		}
+		for _, field := range structType.Fields.List {
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			goType := string(src[field.Type.Pos()-1 : field.Type.End()-1])
+			if goTypes[goType] == nil {
+				t.Fatalf("unexpected go type %s", goType)
+			}
+			fields = append(fields, reflect.StructField{Name: field.Names[0].Name, Type: goTypes[goType], Tag: reflect.StructTag(tag)})
+		}
+		return false
+	})
+	return fields
+}
+
+func TestParseIndexFields(t *testing.T) {
+	fields := parseIndexFields("CREATE TAG INDEX `idx_player_name_age` ON `player` (\n `name`(10),\n `age`\n)")
+	assert.Equal(t, []*IndexField{{Prop: "name", Length: 10}, {Prop: "age"}}, fields)
+	assert.Empty(t, parseIndexFields("CREATE TAG INDEX `idx_player` ON `player` (\n)"))
+}
@@ -0,0 +1,113 @@
+package gen
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/haysons/norm"
+	"github.com/haysons/norm/resolver"
+)
+
+// Load reads the schema of the graph space the db connects to by SHOW TAGS, SHOW EDGES, DESCRIBE and SHOW CREATE.
+// If names are given, only the tags and edges with these names are loaded.
+func Load(db *norm.DB, names ...string) (*Schema, error) {
+	migrator := db.Migrator()
+	space, err := migrator.DescSpace(db.SpaceName())
+	if err != nil {
+		return nil, err
+	}
+	schema := &Schema{VIDType: space.VIDType}
+	if schema.Tags, err = loadDefinitions(db, "TAG", names); err != nil {
+		return nil, err
+	}
+	if schema.Edges, err = loadDefinitions(db, "EDGE", names); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// loadDefinitions loads the tags or edges according to the kind, which is TAG or EDGE
+func loadDefinitions(db *norm.DB, kind string, names []string) ([]*Definition, error) {
+	schemaNames := make([]string, 0)
+	if err := db.Raw("SHOW "+kind+"S").FindCol("Name", &schemaNames); err != nil {
+		return nil, err
+	}
+	indexes := make([]*indexDesc, 0)
+	if err := db.Raw("SHOW " + kind + " INDEXES").Find(&indexes); err != nil {
+		return nil, err
+	}
+
+	definitions := make([]*Definition, 0, len(schemaNames))
+	for _, name := range schemaNames {
+		if len(names) > 0 && !slices.Contains(names, name) {
+			continue
+		}
+		def := &Definition{Name: name}
+		props := make([]*norm.PropDesc, 0)
+		if err := db.Raw("DESCRIBE " + kind + " " + name).Find(&props); err != nil {
+			return nil, err
+		}
+		def.Props = props
+		createSchema := make([]string, 0, 1)
+		if err := db.Raw("SHOW CREATE "+kind+" "+name).FindCol("Create "+titleCase(kind), &createSchema); err != nil {
+			return nil, err
+		}
+		if len(createSchema) > 0 {
+			def.TTLCol, def.TTLDuration = resolver.ParseSchemaTTL(createSchema[0])
+		}
+		for _, index := range indexes {
+			if index.Tag+index.Edge != name {
+				continue
+			}
+			createIndex := make([]string, 0, 1)
+			err := db.Raw("SHOW CREATE "+kind+" INDEX "+index.Name).
+				FindCol("Create "+titleCase(kind)+" Index", &createIndex)
+			if err != nil {
+				return nil, err
+			}
+			if len(createIndex) > 0 {
+				def.Indexes = append(def.Indexes, &Index{Name: index.Name, Fields: parseIndexFields(createIndex[0])})
+			}
+		}
+		definitions = append(definitions, def)
+	}
+	return definitions, nil
+}
+
+// indexDesc is a row of SHOW TAG INDEXES or SHOW EDGE INDEXES, only one of Tag and Edge is returned
+type indexDesc struct {
+	Name string `norm:"col:Index Name"`
+	Tag  string `norm:"col:By Tag"`
+	Edge string `norm:"col:By Edge"`
+}
+
+func titleCase(kind string) string {
+	return kind[:1] + strings.ToLower(kind[1:])
+}
+
+var indexFieldPattern = regexp.MustCompile("`([^`]+)`(?:\\((\\d+)\\))?")
+
+// parseIndexFields parses the fields from the result of SHOW CREATE TAG INDEX or SHOW CREATE EDGE INDEX, such as
+//
+//	CREATE TAG INDEX `idx_player_name_age` ON `player` (
+//	 `name`(10),
+//	 `age`
+//	)
+func parseIndexFields(createIndex string) []*IndexField {
+	start := strings.Index(createIndex, "(")
+	end := strings.LastIndex(createIndex, ")")
+	if start < 0 || end < start {
+		return nil
+	}
+	fields := make([]*IndexField, 0)
+	for _, matches := range indexFieldPattern.FindAllStringSubmatch(createIndex[start+1:end], -1) {
+		field := &IndexField{Prop: matches[1]}
+		if matches[2] != "" {
+			field.Length, _ = strconv.Atoi(matches[2])
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
@@ -9,7 +9,11 @@ import (
 
 type ChainInterface[T any] interface {
 	ExecInterface[T]
-	Raw(raw string) ChainInterface[T]
+	Raw(raw string, args ...any) ChainInterface[T]
+	Parameterized() ChainInterface[T]
+	Idempotent() ChainInterface[T]
+	Unscoped() ChainInterface[T]
+	HardDelete() ChainInterface[T]
 	Go(step ...int) ChainInterface[T]
 	From(vid any) ChainInterface[T]
 	Over(edgeType ...string) ChainInterface[T]
@@ -26,6 +30,15 @@ type ChainInterface[T any] interface {
 	OrderBy(expr string) ChainInterface[T]
 	Limit(limit int) ChainInterface[T]
 	GetSubgraph(steps int, withProp ...bool) ChainInterface[T]
+	FindPath(pathType string, withProp ...bool) ChainInterface[T]
+	To(vid any) ChainInterface[T]
+	Upto(steps int) ChainInterface[T]
+	Match(patterns ...any) ChainInterface[T]
+	OptionalMatch(patterns ...any) ChainInterface[T]
+	With(expr string, distinct ...bool) ChainInterface[T]
+	Unwind(expr string, alias string) ChainInterface[T]
+	Return(expr string, distinct ...bool) ChainInterface[T]
+	Skip(offset int) ChainInterface[T]
 	In(edgeTypes ...string) ChainInterface[T]
 	Out(edgeTypes ...string) ChainInterface[T]
 	Both(edgeTypes ...string) ChainInterface[T]
@@ -43,12 +56,15 @@ type ChainInterface[T any] interface {
 
 type ExecInterface[T any] interface {
 	NGQL(ctx context.Context) (string, error)
+	NGQLWithParams(ctx context.Context) (string, map[string]any, error)
 	RawResult(ctx context.Context) (*nebula.ResultSet, error)
 	Exec(ctx context.Context) error
 	Find(ctx context.Context) ([]T, error)
 	FindCol(ctx context.Context, col string) ([]T, error)
 	Take(ctx context.Context) (T, error)
 	TakeCol(ctx context.Context, col string) (T, error)
+	Iter(ctx context.Context, opts ...RowsOption) (*Iterator[T], error)
+	FindPage(ctx context.Context, page int, size int) (*Page[T], error)
 }
 
 type op func(*DB) *DB
@@ -73,8 +89,11 @@ type g[T any] struct {
 	ops []op
 }
 
-func (g *g[T]) apply(_ context.Context) *DB {
+func (g *g[T]) apply(ctx context.Context) *DB {
 	db := g.db.session()
+	if ctx != nil {
+		db.ctx = ctx
+	}
 
 	for _, op := range g.ops {
 		db = op(db)
@@ -95,9 +114,33 @@ func (c chainG[T]) with(v op) chainG[T] {
 	}
 }
 
-func (c chainG[T]) Raw(raw string) ChainInterface[T] {
+func (c chainG[T]) Raw(raw string, args ...any) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Raw(raw, args...)
+	})
+}
+
+func (c chainG[T]) Parameterized() ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Parameterized()
+	})
+}
+
+func (c chainG[T]) Idempotent() ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Idempotent()
+	})
+}
+
+func (c chainG[T]) Unscoped() ChainInterface[T] {
 	return c.with(func(db *DB) *DB {
-		return db.Raw(raw)
+		return db.Unscoped()
+	})
+}
+
+func (c chainG[T]) HardDelete() ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.HardDelete()
 	})
 }
 
@@ -197,6 +240,60 @@ func (c chainG[T]) GetSubgraph(steps int, withProp ...bool) ChainInterface[T] {
 	})
 }
 
+func (c chainG[T]) FindPath(pathType string, withProp ...bool) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.FindPath(pathType, withProp...)
+	})
+}
+
+func (c chainG[T]) To(vid any) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.To(vid)
+	})
+}
+
+func (c chainG[T]) Upto(steps int) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Upto(steps)
+	})
+}
+
+func (c chainG[T]) Match(patterns ...any) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Match(patterns...)
+	})
+}
+
+func (c chainG[T]) OptionalMatch(patterns ...any) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.OptionalMatch(patterns...)
+	})
+}
+
+func (c chainG[T]) With(expr string, distinct ...bool) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.With(expr, distinct...)
+	})
+}
+
+func (c chainG[T]) Unwind(expr string, alias string) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Unwind(expr, alias)
+	})
+}
+
+func (c chainG[T]) Return(expr string, distinct ...bool) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Return(expr, distinct...)
+	})
+}
+
+func (c chainG[T]) Skip(offset int) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Skip(offset)
+	})
+}
+
 func (c chainG[T]) In(edgeTypes ...string) ChainInterface[T] {
 	return c.with(func(db *DB) *DB {
 		return db.In(edgeTypes...)
@@ -235,7 +332,11 @@ func (c chainG[T]) UpsertVertex(vid any, propsUpdate any, opts ...clause.Option)
 
 func (c chainG[T]) DeleteVertex(vid any, withEdge ...bool) ChainInterface[T] {
 	return c.with(func(db *DB) *DB {
-		return db.DeleteVertex(vid, withEdge...)
+		// the vertices given as vids are soft deleted by the tags of T
+		var model T
+		tx := db.getInstance()
+		tx.Statement.VertexModel(model)
+		return tx.DeleteVertex(vid, withEdge...)
 	})
 }
 
@@ -283,6 +384,10 @@ func (g execG[T]) NGQL(ctx context.Context) (string, error) {
 	return g.g.apply(ctx).NGQL()
 }
 
+func (g execG[T]) NGQLWithParams(ctx context.Context) (string, map[string]any, error) {
+	return g.g.apply(ctx).NGQLWithParams()
+}
+
 func (g execG[T]) RawResult(ctx context.Context) (*nebula.ResultSet, error) {
 	return g.g.apply(ctx).RawResult()
 }
@@ -314,3 +419,20 @@ func (g execG[T]) TakeCol(ctx context.Context, col string) (T, error) {
 	err := g.g.apply(ctx).TakeCol(col, &r)
 	return r, err
 }
+
+func (g execG[T]) Iter(ctx context.Context, opts ...RowsOption) (*Iterator[T], error) {
+	rows, err := g.g.apply(ctx).Rows(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Iterator[T]{rows: rows}, nil
+}
+
+func (g execG[T]) FindPage(ctx context.Context, page int, size int) (*Page[T], error) {
+	var r []T
+	info, err := g.g.apply(ctx).Paginate(page, size, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &Page[T]{Items: r, PageInfo: *info}, nil
+}
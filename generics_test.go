@@ -0,0 +1,62 @@
+package norm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+type softPlayer struct {
+	VID       string     `norm:"vertex_id"`
+	Name      string     `norm:"prop:name"`
+	DeletedAt *time.Time `norm:"prop:deleted_at;soft_delete"`
+}
+
+func (p softPlayer) VertexID() string {
+	return p.VID
+}
+
+func (p softPlayer) VertexTagName() string {
+	return "player"
+}
+
+func TestGenericsDeleteVertex(t *testing.T) {
+	tests := []struct {
+		exec func(db *DB) error
+		want string
+	}{
+		{
+			exec: func(db *DB) error {
+				return G[softPlayer](db).DeleteVertex("player100").Exec(context.Background())
+			},
+			want: `UPDATE VERTEX ON player "player100" SET deleted_at = datetime();`,
+		},
+		{
+			exec: func(db *DB) error {
+				return G[retryPlayer](db).DeleteVertex("player100").Exec(context.Background())
+			},
+			want: `DELETE VERTEX "player100";`,
+		},
+		{
+			exec: func(db *DB) error {
+				return db.DeleteVertex("player100").Exec()
+			},
+			want: `DELETE VERTEX "player100";`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			var got string
+			db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+				got = nGQL
+				return genDataSet(t, nil)
+			})
+			assert.NoError(t, tt.exec(db))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
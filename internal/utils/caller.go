@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// normPkgPath is the import path of norm, the functions of its packages are named with it as the prefix
+const normPkgPath = "github.com/haysons/norm"
+
+// FileWithLineNum returns the file:line of the first caller outside norm, test files of norm are regarded as callers
+func FileWithLineNum() string {
+	pcs := [16]uintptr{}
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if isCallerFrame(frame) {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+	return ""
+}
+
+// isCallerFrame reports whether the frame is outside norm, the frames are matched by the package of their function
+// rather than their file, as the files are not located under the same directory when norm is a dependency, or when
+// the paths are trimmed
+func isCallerFrame(frame runtime.Frame) bool {
+	if strings.HasSuffix(frame.File, "_test.go") {
+		return true
+	}
+	pkgPath := frame.Function
+	// the function name is the package path followed by a dot and the name, such as github.com/haysons/norm.(*DB).Find
+	if slash := strings.LastIndexByte(pkgPath, '/'); slash >= 0 {
+		if dot := strings.IndexByte(pkgPath[slash:], '.'); dot >= 0 {
+			pkgPath = pkgPath[:slash+dot]
+		}
+	} else if dot := strings.IndexByte(pkgPath, '.'); dot >= 0 {
+		pkgPath = pkgPath[:dot]
+	}
+	return pkgPath != normPkgPath && !strings.HasPrefix(pkgPath, normPkgPath+"/")
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWithLineNum(t *testing.T) {
+	fileLine := FileWithLineNum()
+	assert.True(t, strings.Contains(fileLine, "caller_test.go:"), fileLine)
+}
+
+func TestIsCallerFrame(t *testing.T) {
+	tests := []struct {
+		frame runtime.Frame
+		want  bool
+	}{
+		{
+			frame: runtime.Frame{Function: "github.com/haysons/norm.(*DB).Find", File: "/go/pkg/mod/github.com/haysons/norm@v1.0.0/result.go"},
+			want:  false,
+		},
+		{
+			frame: runtime.Frame{Function: "github.com/haysons/norm.G[...].func1", File: "github.com/haysons/norm@v1.0.0/generics.go"},
+			want:  false,
+		},
+		{
+			frame: runtime.Frame{Function: "github.com/haysons/norm/statement.(*Statement).Build", File: "/src/norm/statement/statement.go"},
+			want:  false,
+		},
+		{
+			frame: runtime.Frame{Function: "github.com/haysons/norm/otel.(*Plugin).after", File: "/src/norm/otel/otel.go"},
+			want:  false,
+		},
+		{
+			frame: runtime.Frame{Function: "github.com/haysons/norm.TestFind", File: "/src/norm/result_test.go"},
+			want:  true,
+		},
+		{
+			frame: runtime.Frame{Function: "main.main", File: "/src/norm/example/basic/main.go"},
+			want:  true,
+		},
+		{
+			frame: runtime.Frame{Function: "github.com/haysons/normx.(*Repo).Find", File: "/src/normx/repo.go"},
+			want:  true,
+		},
+		{
+			frame: runtime.Frame{Function: "example.com/app/dao.(*PlayerDao).Find", File: "/src/norm/app/dao/player.go"},
+			want:  true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, isCallerFrame(tt.frame))
+		})
+	}
+}
@@ -52,23 +52,31 @@ func PtrValue(destValue reflect.Value) reflect.Value {
 	return destValue
 }
 
-func StructFields(t reflect.Type) []reflect.StructField {
-	return structFields(t, nil)
+// FieldByIndexAlloc returns the nested field of the struct value, the nil pointers to embedded structs on the way
+// are allocated. If a nil pointer cannot be allocated, such as the pointer to an unexported embedded struct, the zero
+// Value is returned, which is not settable.
+func FieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
 }
 
-func structFields(t reflect.Type, parentIndex []int) []reflect.StructField {
-	if t.Kind() == reflect.Ptr {
-		t = t.Elem()
-	}
-	fields := make([]reflect.StructField, 0)
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		field.Index = append(parentIndex, field.Index...)
-		if field.Anonymous {
-			fields = append(fields, structFields(field.Type, field.Index)...)
-		} else {
-			fields = append(fields, field)
-		}
+// FieldByIndexOrZero returns the nested field of the struct value, the zero value of the field is returned if there is
+// a nil pointer to an embedded struct on the way
+func FieldByIndexOrZero(v reflect.Value, index []int) reflect.Value {
+	field, err := v.FieldByIndexErr(index)
+	if err != nil {
+		return reflect.Zero(v.Type().FieldByIndex(index).Type)
 	}
-	return fields
+	return field
 }
@@ -80,3 +80,24 @@ func TestPtrValue(t *testing.T) {
 	cValue.SetString("hello")
 	assert.Equal(t, ***c, "hello")
 }
+
+func TestFieldByIndex(t *testing.T) {
+	type Base struct {
+		ID int
+	}
+	type model struct {
+		*Base
+		Name string
+	}
+	m := model{Name: "hayson"}
+	mValue := reflect.ValueOf(&m).Elem()
+	assert.Equal(t, 0, FieldByIndexOrZero(mValue, []int{0, 0}).Interface())
+	assert.Nil(t, m.Base)
+	assert.Equal(t, "hayson", FieldByIndexOrZero(mValue, []int{1}).Interface())
+
+	FieldByIndexAlloc(mValue, []int{0, 0}).SetInt(10)
+	if assert.NotNil(t, m.Base) {
+		assert.Equal(t, 10, m.ID)
+	}
+	assert.Equal(t, 10, FieldByIndexOrZero(mValue, []int{0, 0}).Interface())
+}
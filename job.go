@@ -0,0 +1,101 @@
+package norm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// jobPollInterval the interval between the polls of the status of the job in Job.Wait
+var jobPollInterval = time.Second
+
+// JobStatus is the status of a job of nebula graph
+type JobStatus string
+
+const (
+	JobStatusQueue    JobStatus = "QUEUE"
+	JobStatusRunning  JobStatus = "RUNNING"
+	JobStatusFinished JobStatus = "FINISHED"
+	JobStatusFailed   JobStatus = "FAILED"
+	JobStatusStopped  JobStatus = "STOPPED"
+)
+
+// Done reports whether the job has completed, whether successful or not
+func (s JobStatus) Done() bool {
+	return s == JobStatusFinished || s == JobStatusFailed || s == JobStatusStopped
+}
+
+// JobDesc is the description of a job returned by SHOW JOB
+type JobDesc struct {
+	ID        int64     `norm:"col:Job Id(TaskId)"`
+	Command   string    `norm:"col:Command(Dest)"`
+	Status    JobStatus `norm:"col:Status"`
+	ErrorCode string    `norm:"col:Error Code"`
+}
+
+// Job is the handle of a job running in the background of nebula graph, such as rebuilding indexes.
+// The statements of the job are executed in the graph space where the job is submitted.
+type Job struct {
+	ID int64
+	db *DB
+}
+
+// newJob creates the handle of the job submitted by the statement of tx, the job id is read from the result, and the
+// statements of the job are executed through db
+func newJob(db *DB, tx *DB) (*Job, error) {
+	jobIDs := make([]int64, 0, 1)
+	if err := tx.FindCol("New Job Id", &jobIDs); err != nil {
+		return nil, err
+	}
+	if len(jobIDs) == 0 {
+		return nil, fmt.Errorf("norm: %w, no job id is returned", ErrRecordNotFound)
+	}
+	return &Job{ID: jobIDs[0], db: db}, nil
+}
+
+// Desc returns the description of the job
+func (j *Job) Desc(ctx context.Context) (*JobDesc, error) {
+	desc := new(JobDesc)
+	err := j.db.withContext(ctx).Raw("SHOW JOB " + strconv.FormatInt(j.ID, 10)).
+		Take(desc)
+	if err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// Status returns the current status of the job
+func (j *Job) Status(ctx context.Context) (JobStatus, error) {
+	desc, err := j.Desc(ctx)
+	if err != nil {
+		return "", err
+	}
+	return desc.Status, nil
+}
+
+// Wait polls the status of the job every second until it is done or the context is done. An error wrapping ErrJobFailed
+// is returned if the job failed or was stopped.
+func (j *Job) Wait(ctx context.Context) error {
+	for {
+		desc, err := j.Desc(ctx)
+		if err != nil {
+			return err
+		}
+		switch desc.Status {
+		case JobStatusFinished:
+			return nil
+		case JobStatusFailed, JobStatusStopped:
+			return fmt.Errorf("norm: %w, job %d is %s, error code: %s", ErrJobFailed, j.ID, desc.Status, desc.ErrorCode)
+		}
+		if err = sleepContext(ctx, jobPollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// Stop stops the job if it is still queued or running
+func (j *Job) Stop(ctx context.Context) error {
+	return j.db.withContext(ctx).Raw("STOP JOB " + strconv.FormatInt(j.ID, 10)).
+		Exec()
+}
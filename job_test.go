@@ -0,0 +1,111 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+func TestNewJob(t *testing.T) {
+	tests := []struct {
+		res      func(t *testing.T) *nebula.ResultSet
+		want     int64
+		wantErr  bool
+		notFound bool
+	}{
+		{
+			res: func(t *testing.T) *nebula.ResultSet {
+				return genDataSet(t, []string{"New Job Id"}, []any{42})
+			},
+			want: 42,
+		},
+		{
+			res: func(t *testing.T) *nebula.ResultSet {
+				return genDataSet(t, []string{"New Job Id"})
+			},
+			wantErr:  true,
+			notFound: true,
+		},
+		{
+			res: func(t *testing.T) *nebula.ResultSet {
+				return genResultSet(t, nebula.ErrorCode_E_EXECUTION_ERROR)
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			var got string
+			db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+				got = nGQL
+				return tt.res(t)
+			})
+			job, err := db.Migrator().RebuildVertexTagIndexes("idx_player_name")
+			assert.Equal(t, "REBUILD TAG INDEX idx_player_name;", got)
+			if tt.wantErr {
+				var nebulaErr *NebulaError
+				assert.Equal(t, tt.notFound, errors.Is(err, ErrRecordNotFound))
+				assert.Equal(t, !tt.notFound, errors.As(err, &nebulaErr))
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, job.ID)
+			}
+		})
+	}
+}
+
+func TestJobWait(t *testing.T) {
+	interval := jobPollInterval
+	jobPollInterval = time.Millisecond
+	defer func() {
+		jobPollInterval = interval
+	}()
+	cols := []string{"Job Id(TaskId)", "Command(Dest)", "Status", "Start Time", "Stop Time", "Error Code"}
+	tests := []struct {
+		statuses []JobStatus
+		polls    int
+		wantErr  error
+	}{
+		{statuses: []JobStatus{JobStatusFinished}, polls: 1},
+		{statuses: []JobStatus{JobStatusQueue, JobStatusRunning, JobStatusFinished}, polls: 3},
+		{statuses: []JobStatus{JobStatusRunning, JobStatusFailed}, polls: 2, wantErr: ErrJobFailed},
+		{statuses: []JobStatus{JobStatusStopped}, polls: 1, wantErr: ErrJobFailed},
+		{statuses: []JobStatus{JobStatusRunning}, polls: 1, wantErr: context.DeadlineExceeded},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			polls := 0
+			db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+				assert.Equal(t, "SHOW JOB 42", nGQL)
+				status := tt.statuses[len(tt.statuses)-1]
+				if polls < len(tt.statuses) {
+					status = tt.statuses[polls]
+				}
+				polls++
+				return genDataSet(t, cols, []any{42, "REBUILD_TAG_INDEX", string(status), nil, nil, "SUCCEEDED"})
+			})
+			job := &Job{ID: 42, db: db}
+			ctx := context.Background()
+			if tt.wantErr == context.DeadlineExceeded {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Millisecond/2)
+				defer cancel()
+			}
+			err := job.Wait(ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantErr != context.DeadlineExceeded {
+				assert.Equal(t, tt.polls, polls)
+			}
+		})
+	}
+}
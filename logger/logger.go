@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"time"
 )
 
 type Level int
@@ -18,9 +19,18 @@ const (
 	SilentLevel // Below this level, norm will not print any logs or trace information.
 )
 
+// TraceRecord records the execution of a statement
 type TraceRecord struct {
-	NGQL string
-	Err  error
+	NGQL      string         // the executed statement
+	Params    map[string]any // the parameters of a parameterized statement
+	Err       error          // the error returned by the execution
+	Begin     time.Time      // the time the execution began
+	Elapsed   time.Duration  // the wall time of the execution, including the time waiting for a session
+	Latency   time.Duration  // the latency reported by the server
+	RowCount  int            // the number of rows returned
+	SpaceName string         // the graph space the statement is executed in
+	Operation string         // the kind of operation, such as query, insert, update, delete or migrate
+	Caller    string         // the file:line where the execution is called
 }
 
 type Interface interface {
@@ -46,11 +56,14 @@ type defaultLogger struct {
 type Config struct {
 	Colorful bool
 	LogLevel Level
+	// SlowThreshold statements that take longer than it are traced as warn logs, zero means disabled
+	SlowThreshold time.Duration
 }
 
 var Default = New(os.Stdout, Config{
-	Colorful: true,
-	LogLevel: WarnLevel,
+	Colorful:      true,
+	LogLevel:      WarnLevel,
+	SlowThreshold: 200 * time.Millisecond,
 })
 
 func New(writer io.Writer, conf Config) Interface {
@@ -104,9 +117,19 @@ func (l *defaultLogger) Trace(ctx context.Context, record *TraceRecord) {
 	if l.LogLevel >= SilentLevel || record == nil {
 		return
 	}
-	if record.Err == nil {
-		l.message(ctx, DebugLevel, l.debugStr+"[norm] nGQL: %s", record.NGQL)
-	} else {
-		l.message(ctx, DebugLevel, l.debugStr+"[norm] nGQL: %s err: %v", record.NGQL, record.Err)
+	switch {
+	case record.Err != nil:
+		l.message(ctx, DebugLevel, l.debugStr+"[norm] %s [%.3fms] [rows:%d] nGQL: %s err: %v",
+			record.Caller, msOf(record.Elapsed), record.RowCount, record.NGQL, record.Err)
+	case l.SlowThreshold > 0 && record.Elapsed > l.SlowThreshold:
+		l.message(ctx, WarnLevel, l.warnStr+"[norm] %s slow query >= %v [%.3fms] [latency:%.3fms] [rows:%d] nGQL: %s",
+			record.Caller, l.SlowThreshold, msOf(record.Elapsed), msOf(record.Latency), record.RowCount, record.NGQL)
+	default:
+		l.message(ctx, DebugLevel, l.debugStr+"[norm] %s [%.3fms] [latency:%.3fms] [rows:%d] nGQL: %s",
+			record.Caller, msOf(record.Elapsed), msOf(record.Latency), record.RowCount, record.NGQL)
 	}
 }
+
+func msOf(d time.Duration) float64 {
+	return float64(d.Nanoseconds()) / 1e6
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestLogger(t *testing.T) {
@@ -31,6 +32,21 @@ func TestLogger(t *testing.T) {
 		NGQL: `GO FROM "player102" OVER serve YIELD dst(edge);`,
 	})
 
+	logger = New(os.Stdout, Config{
+		LogLevel:      WarnLevel,
+		SlowThreshold: 100 * time.Millisecond,
+	})
+	logger.Trace(ctx, &TraceRecord{
+		NGQL:      `GO FROM "player102" OVER serve YIELD dst(edge);`,
+		Begin:     time.Now(),
+		Elapsed:   200 * time.Millisecond,
+		Latency:   150 * time.Millisecond,
+		RowCount:  2,
+		SpaceName: "demo_basketballplayer",
+		Operation: "query",
+		Caller:    "main.go:10",
+	})
+
 	logger = logger.LogMode(SilentLevel)
 	logger.Error(ctx, "error message")
 }
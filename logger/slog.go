@@ -0,0 +1,113 @@
+//go:build go1.21
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// SlogConfig configures the logger built on top of *slog.Logger, the Colorful field of Config is ignored
+type SlogConfig struct {
+	Config
+	// Levels maps the norm log levels to slog levels, the levels that are not specified are mapped to
+	// slog.LevelDebug, slog.LevelInfo, slog.LevelWarn and slog.LevelError respectively
+	Levels map[Level]slog.Level
+}
+
+type slogLogger struct {
+	SlogConfig
+	logger *slog.Logger
+}
+
+// NewSlog creates a logger that writes through the given *slog.Logger, trace records are written as structured
+// attributes, such as ngql, err, elapsed and rows, rather than formatted into the message.
+func NewSlog(logger *slog.Logger, conf SlogConfig) Interface {
+	return &slogLogger{
+		SlogConfig: conf,
+		logger:     logger,
+	}
+}
+
+func (l *slogLogger) LogMode(level Level) Interface {
+	newLogger := *l
+	newLogger.LogLevel = level
+	return &newLogger
+}
+
+func (l *slogLogger) Debug(ctx context.Context, msg string, data ...any) {
+	l.message(ctx, DebugLevel, msg, data...)
+}
+
+func (l *slogLogger) Info(ctx context.Context, msg string, data ...any) {
+	l.message(ctx, InfoLevel, msg, data...)
+}
+
+func (l *slogLogger) Warn(ctx context.Context, msg string, data ...any) {
+	l.message(ctx, WarnLevel, msg, data...)
+}
+
+func (l *slogLogger) Error(ctx context.Context, msg string, data ...any) {
+	l.message(ctx, ErrorLevel, msg, data...)
+}
+
+func (l *slogLogger) message(ctx context.Context, level Level, msg string, data ...any) {
+	if level < l.LogLevel {
+		return
+	}
+	l.logger.Log(ctx, l.slogLevel(level), fmt.Sprintf(msg, data...))
+}
+
+func (l *slogLogger) Trace(ctx context.Context, record *TraceRecord) {
+	if l.LogLevel >= SilentLevel || record == nil {
+		return
+	}
+	level, msg := DebugLevel, "norm trace"
+	if record.Err == nil && l.SlowThreshold > 0 && record.Elapsed > l.SlowThreshold {
+		level, msg = WarnLevel, "norm slow query"
+	}
+	if level < l.LogLevel {
+		return
+	}
+	slogLevel := l.slogLevel(level)
+	if !l.logger.Enabled(ctx, slogLevel) {
+		return
+	}
+	attrs := make([]slog.Attr, 0, 10)
+	attrs = append(attrs,
+		slog.String("ngql", record.NGQL),
+		slog.Duration("elapsed", record.Elapsed),
+		slog.Duration("latency", record.Latency),
+		slog.Int("rows", record.RowCount),
+		slog.String("space", record.SpaceName),
+		slog.String("operation", record.Operation),
+		slog.String("caller", record.Caller),
+	)
+	if len(record.Params) > 0 {
+		attrs = append(attrs, slog.Any("params", record.Params))
+	}
+	if record.Err != nil {
+		attrs = append(attrs, slog.String("err", record.Err.Error()))
+	}
+	if level == WarnLevel {
+		attrs = append(attrs, slog.Duration("slow_threshold", l.SlowThreshold))
+	}
+	l.logger.LogAttrs(ctx, slogLevel, msg, attrs...)
+}
+
+func (l *slogLogger) slogLevel(level Level) slog.Level {
+	if slogLevel, ok := l.Levels[level]; ok {
+		return slogLevel
+	}
+	switch level {
+	case DebugLevel:
+		return slog.LevelDebug
+	case InfoLevel:
+		return slog.LevelInfo
+	case WarnLevel:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
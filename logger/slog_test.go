@@ -0,0 +1,87 @@
+//go:build go1.21
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlogLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	ctx := context.Background()
+	nGQL := `GO FROM "player102" OVER serve YIELD dst(edge);`
+
+	tests := []struct {
+		conf      SlogConfig
+		log       func(l Interface)
+		wantLevel string
+		wantMsg   string
+		wantAttrs map[string]any
+	}{
+		{
+			conf:      SlogConfig{Config: Config{LogLevel: DebugLevel}},
+			log:       func(l Interface) { l.Info(ctx, "info %s", "message") },
+			wantLevel: "INFO",
+			wantMsg:   "info message",
+		},
+		{
+			conf: SlogConfig{Config: Config{LogLevel: WarnLevel}},
+			log:  func(l Interface) { l.Info(ctx, "info message") },
+		},
+		{
+			conf:      SlogConfig{Config: Config{LogLevel: DebugLevel}, Levels: map[Level]slog.Level{WarnLevel: slog.LevelError}},
+			log:       func(l Interface) { l.Warn(ctx, "warn message") },
+			wantLevel: "ERROR",
+			wantMsg:   "warn message",
+		},
+		{
+			conf: SlogConfig{Config: Config{LogLevel: DebugLevel}},
+			log: func(l Interface) {
+				l.Trace(ctx, &TraceRecord{NGQL: nGQL, Err: errors.New("error"), Elapsed: time.Millisecond, RowCount: 0})
+			},
+			wantLevel: "DEBUG",
+			wantMsg:   "norm trace",
+			wantAttrs: map[string]any{"ngql": nGQL, "err": "error", "rows": float64(0), "elapsed": float64(time.Millisecond)},
+		},
+		{
+			conf: SlogConfig{Config: Config{LogLevel: WarnLevel, SlowThreshold: 100 * time.Millisecond}},
+			log: func(l Interface) {
+				l.Trace(ctx, &TraceRecord{NGQL: nGQL, Elapsed: 200 * time.Millisecond, RowCount: 2, Operation: "query"})
+			},
+			wantLevel: "WARN",
+			wantMsg:   "norm slow query",
+			wantAttrs: map[string]any{"ngql": nGQL, "rows": float64(2), "operation": "query"},
+		},
+		{
+			conf: SlogConfig{Config: Config{LogLevel: WarnLevel, SlowThreshold: 100 * time.Millisecond}},
+			log: func(l Interface) {
+				l.Trace(ctx, &TraceRecord{NGQL: nGQL, Elapsed: 10 * time.Millisecond})
+			},
+		},
+	}
+	for _, tt := range tests {
+		buf.Reset()
+		tt.log(NewSlog(slog.New(handler), tt.conf))
+		if tt.wantMsg == "" {
+			assert.Empty(t, buf.String())
+			continue
+		}
+		entry := make(map[string]any)
+		if assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry)) {
+			assert.Equal(t, tt.wantLevel, entry["level"])
+			assert.Equal(t, tt.wantMsg, entry["msg"])
+			for k, v := range tt.wantAttrs {
+				assert.Equal(t, v, entry[k], k)
+			}
+		}
+	}
+}
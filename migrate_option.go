@@ -0,0 +1,71 @@
+package norm
+
+import "slices"
+
+// MigrateOption enables the destructive operations of the migration, which are never performed by default. Each option
+// takes a DropConfirm that decides which schema items can be dropped, a nil DropConfirm leaves the option disabled, so
+// that nothing is dropped by accident.
+//
+//	migrator := db.Migrator().WithOptions(
+//		norm.WithDropUnknownProps(norm.AllowDrop("player.nickname")),
+//		norm.WithDropUnknownIndexes(func(kind norm.SchemaKind, schema, item string) bool {
+//			return askOperator(kind, schema, item)
+//		}),
+//	)
+//	err := migrator.AutoMigrateVertexes(Player{})
+type MigrateOption func(opts *migrateOptions)
+
+// DropConfirm is called for each schema item that would be dropped, the item is dropped only if true is returned.
+// kind is the kind of the schema, schema is the name of the tag or edge, item is the name of the prop or index.
+type DropConfirm func(kind SchemaKind, schema, item string) bool
+
+type migrateOptions struct {
+	dropUnknownProps       DropConfirm
+	dropUnknownIndexes     DropConfirm
+	recreateChangedIndexes DropConfirm
+}
+
+// WithDropUnknownProps drops the props that exist in the schema but are no longer declared by the struct. If the
+// dropped prop is the ttl col and the struct declares no ttl, the ttl is removed first.
+func WithDropUnknownProps(confirm DropConfirm) MigrateOption {
+	return func(opts *migrateOptions) {
+		opts.dropUnknownProps = confirm
+	}
+}
+
+// WithDropUnknownIndexes drops the indexes on the schema that are not declared by the struct
+func WithDropUnknownIndexes(confirm DropConfirm) MigrateOption {
+	return func(opts *migrateOptions) {
+		opts.dropUnknownIndexes = confirm
+	}
+}
+
+// WithRecreateChangedIndexes drops and creates again the indexes whose columns differ from the ones declared by the
+// struct. Like the newly created indexes, the recreated ones must be rebuilt to index the existing data.
+func WithRecreateChangedIndexes(confirm DropConfirm) MigrateOption {
+	return func(opts *migrateOptions) {
+		opts.recreateChangedIndexes = confirm
+	}
+}
+
+// AllowDrop returns a DropConfirm that allows dropping the listed items only, each item is written as
+// <schema>.<item>, such as player.nickname or player.idx_player_name
+func AllowDrop(items ...string) DropConfirm {
+	return func(_ SchemaKind, schema, item string) bool {
+		return slices.Contains(items, schema+"."+item)
+	}
+}
+
+func (opts *migrateOptions) confirm(confirm DropConfirm, kind SchemaKind, schema, item string) bool {
+	return confirm != nil && confirm(kind, schema, item)
+}
+
+// WithOptions returns a Migrator that migrates with the given options, they apply to both the plans and the
+// automatic migrations
+func (m *Migrator) WithOptions(opts ...MigrateOption) *Migrator {
+	options := m.options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Migrator{db: m.db, options: options}
+}
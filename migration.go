@@ -0,0 +1,351 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/haysons/norm/clause"
+)
+
+// names of the tags that store the history and the lock of the versioned migrations in the graph space
+const (
+	MigrationTagName     = "norm_migration"
+	MigrationLockTagName = "norm_migration_lock"
+	migrationLockVID     = "norm_migration_lock"
+)
+
+// Migration is a hand-written migration, such as a data backfill, an edge type rename or an index change, which is
+// identified by its ID. Up applies the migration and Down reverts it, Down can be nil if the migration cannot be
+// rolled back. The db passed to them is the one of the Migrator, carrying its context.
+type Migration struct {
+	ID   string
+	Up   func(db *DB) error
+	Down func(db *DB) error
+}
+
+// MigrationStatus is the status of a registered migration
+type MigrationStatus struct {
+	ID        string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// migrationRecord is the vertex that records an applied migration, its vertex id is derived from the migration id
+type migrationRecord struct {
+	ID        string    `norm:"prop:id;not_null"`
+	AppliedAt time.Time `norm:"prop:applied_at"`
+}
+
+func (r migrationRecord) VertexID() string {
+	return r.ID
+}
+
+func (r migrationRecord) VertexTagName() string {
+	return MigrationTagName
+}
+
+// migrationLock is the vertex that prevents the versioned migrations from being run concurrently
+type migrationLock struct {
+	Owner    string    `norm:"prop:owner;not_null;default:''"`
+	LockedAt time.Time `norm:"prop:locked_at"`
+}
+
+func (l migrationLock) VertexID() string {
+	return migrationLockVID
+}
+
+func (l migrationLock) VertexTagName() string {
+	return MigrationLockTagName
+}
+
+// VersionedMigrator runs the hand-written migrations in the order they are registered, and records the applied ones
+// in the MigrationTagName tag of the current graph space, so that each migration is applied only once. Migrate and
+// Rollback hold a lock stored in the graph space while running, so that two deployers cannot run them at once.
+//
+//	vm := db.Migrator().Versioned(
+//		&norm.Migration{
+//			ID: "202401010001_backfill_player_level",
+//			Up: func(db *norm.DB) error {
+//				return db.Raw(`LOOKUP ON player YIELD id(vertex) AS vid | UPDATE VERTEX ON player $-.vid SET level = 1`).Exec()
+//			},
+//		},
+//	)
+//	if err := vm.Migrate(); err != nil {
+//		return err
+//	}
+type VersionedMigrator struct {
+	migrator   *Migrator
+	migrations []*Migration
+	owner      string
+	intVID     *bool
+}
+
+// Versioned returns a VersionedMigrator that runs the given migrations in order
+func (m *Migrator) Versioned(migrations ...*Migration) *VersionedMigrator {
+	hostname, _ := os.Hostname()
+	return &VersionedMigrator{
+		migrator:   m,
+		migrations: migrations,
+		owner:      fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+	}
+}
+
+// Migrate applies the migrations that have not been applied yet in order, it stops at the first failed migration.
+// The history and lock tags are created if they do not exist.
+func (vm *VersionedMigrator) Migrate() (err error) {
+	if err = vm.validate(); err != nil {
+		return err
+	}
+	if err = vm.prepare(); err != nil {
+		return err
+	}
+	if err = vm.lock(); err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, vm.unlock())
+	}()
+	applied, err := vm.applied()
+	if err != nil {
+		return err
+	}
+	for _, migration := range vm.migrations {
+		if _, ok := applied[migration.ID]; ok {
+			continue
+		}
+		if err = migration.Up(vm.migrator.db); err != nil {
+			return fmt.Errorf("norm: apply migration %s failed, %w", migration.ID, err)
+		}
+		err = vm.migrator.db.UpsertVertex(vm.vid(migration.ID), map[string]any{
+			"id":         migration.ID,
+			"applied_at": clause.Expr{Str: "datetime()"},
+		}, clause.WithTagName(MigrationTagName)).Exec()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback reverts the last n applied migrations in the reverse order they are registered, it stops at the first
+// failed migration. ErrInvalidValue is returned if n is not positive or any of them has no Down function.
+func (vm *VersionedMigrator) Rollback(n int) (err error) {
+	if n <= 0 {
+		return fmt.Errorf("norm: %w, the number of migrations to roll back must be positive", ErrInvalidValue)
+	}
+	if err = vm.validate(); err != nil {
+		return err
+	}
+	if err = vm.prepare(); err != nil {
+		return err
+	}
+	if err = vm.lock(); err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, vm.unlock())
+	}()
+	applied, err := vm.applied()
+	if err != nil {
+		return err
+	}
+	rollbacks, err := selectRollbacks(vm.migrations, applied, n)
+	if err != nil {
+		return err
+	}
+	for _, migration := range rollbacks {
+		if err = migration.Down(vm.migrator.db); err != nil {
+			return fmt.Errorf("norm: rollback migration %s failed, %w", migration.ID, err)
+		}
+		if err = vm.migrator.db.HardDelete().DeleteVertex(vm.vid(migration.ID)).Exec(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Status returns the status of the registered migrations in order, nothing is changed in the graph space
+func (vm *VersionedMigrator) Status() ([]*MigrationStatus, error) {
+	if err := vm.validate(); err != nil {
+		return nil, err
+	}
+	hasTag, err := vm.migrator.HasVertexTag(MigrationTagName)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[string]time.Time)
+	if hasTag {
+		if applied, err = vm.applied(); err != nil {
+			return nil, err
+		}
+	}
+	statuses := make([]*MigrationStatus, 0, len(vm.migrations))
+	for _, migration := range vm.migrations {
+		appliedAt, ok := applied[migration.ID]
+		statuses = append(statuses, &MigrationStatus{ID: migration.ID, Applied: ok, AppliedAt: appliedAt})
+	}
+	return statuses, nil
+}
+
+// ForceUnlock releases the lock regardless of its owner, it is intended for the lock left behind by a deployer that
+// exited abnormally, make sure no migration is running before calling it.
+func (vm *VersionedMigrator) ForceUnlock() error {
+	if err := vm.resolveVIDType(); err != nil {
+		return err
+	}
+	return vm.migrator.db.UpdateVertex(vm.vid(migrationLockVID), map[string]any{"owner": ""},
+		clause.WithTagName(MigrationLockTagName)).Exec()
+}
+
+// validate checks the registered migrations and resolves the vid type of the graph space
+func (vm *VersionedMigrator) validate() error {
+	if err := validateMigrations(vm.migrations); err != nil {
+		return err
+	}
+	return vm.resolveVIDType()
+}
+
+// validateMigrations checks that every migration has an up function and a unique id
+func validateMigrations(migrations []*Migration) error {
+	ids := make(map[string]struct{}, len(migrations))
+	for _, migration := range migrations {
+		if migration.ID == "" || migration.Up == nil {
+			return fmt.Errorf("norm: %w, migration must have an id and an up function", ErrInvalidValue)
+		}
+		if _, ok := ids[migration.ID]; ok {
+			return fmt.Errorf("norm: %w, duplicate migration id %s", ErrInvalidValue, migration.ID)
+		}
+		ids[migration.ID] = struct{}{}
+	}
+	return nil
+}
+
+// selectRollbacks returns the last n applied migrations in the reverse order they are registered
+func selectRollbacks(migrations []*Migration, applied map[string]time.Time, n int) ([]*Migration, error) {
+	rollbacks := make([]*Migration, 0)
+	for i := len(migrations) - 1; i >= 0 && len(rollbacks) < n; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.ID]; !ok {
+			continue
+		}
+		if migration.Down == nil {
+			return nil, fmt.Errorf("norm: %w, migration %s can not be rolled back", ErrInvalidValue, migration.ID)
+		}
+		rollbacks = append(rollbacks, migration)
+	}
+	return rollbacks, nil
+}
+
+// prepare creates the history and lock tags and waits until they can be used
+func (vm *VersionedMigrator) prepare() error {
+	plan, err := vm.migrator.PlanVertexes(migrationRecord{}, migrationLock{})
+	if err != nil {
+		return err
+	}
+	if plan.Empty() {
+		return nil
+	}
+	if err = vm.migrator.Apply(plan); err != nil {
+		return err
+	}
+	return vm.waitTagsReady()
+}
+
+// waitTagsReady waits until the created tags are visible to the graph service, which takes effect at the next
+// heartbeat like the creation of spaces, see Migrator.WaitSpaceReady
+func (vm *VersionedMigrator) waitTagsReady() error {
+	ctx := vm.migrator.db.Context()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, spaceReadyTimeout)
+		defer cancel()
+	}
+	db := vm.migrator.db.withContext(ctx)
+	for {
+		err := db.Fetch(MigrationTagName+", "+MigrationLockTagName, vm.vid(migrationLockVID)).
+			Yield("id(vertex)").Exec()
+		if !errors.Is(err, ErrTagNotFound) {
+			return err
+		}
+		if err = sleepContext(ctx, spaceReadyInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// lock takes the lock by setting its owner, the lock is taken only if it has no owner. UPSERT is executed atomically
+// by the storage service, the lock vertex is inserted if it does not exist regardless of the condition.
+func (vm *VersionedMigrator) lock() error {
+	owners := make([]string, 0, 1)
+	err := vm.migrator.db.UpsertVertex(vm.vid(migrationLockVID), map[string]any{
+		"owner":     vm.owner,
+		"locked_at": clause.Expr{Str: "datetime()"},
+	}, clause.WithTagName(MigrationLockTagName)).
+		When("owner == ?", "").
+		Yield("owner AS owner").
+		FindCol("owner", &owners)
+	if err != nil {
+		return err
+	}
+	if len(owners) == 0 || owners[0] != vm.owner {
+		return fmt.Errorf("norm: %w, the lock is held by %q", ErrMigrationLocked, strings.Join(owners, ""))
+	}
+	return nil
+}
+
+func (vm *VersionedMigrator) unlock() error {
+	return vm.migrator.db.UpdateVertex(vm.vid(migrationLockVID), map[string]any{"owner": ""},
+		clause.WithTagName(MigrationLockTagName)).
+		When("owner == ?", vm.owner).
+		Exec()
+}
+
+// applied returns the applied time of the applied migrations, keyed by migration id
+func (vm *VersionedMigrator) applied() (map[string]time.Time, error) {
+	applied := make(map[string]time.Time)
+	if len(vm.migrations) == 0 {
+		return applied, nil
+	}
+	vids := make([]clause.Expr, 0, len(vm.migrations))
+	for _, migration := range vm.migrations {
+		vids = append(vids, vm.vid(migration.ID))
+	}
+	records := make([]*migrationRecord, 0)
+	err := vm.migrator.db.Fetch(MigrationTagName, vids).
+		Yield("properties(vertex).id AS id, properties(vertex).applied_at AS applied_at").
+		Find(&records)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		applied[record.ID] = record.AppliedAt
+	}
+	return applied, nil
+}
+
+// vid returns the vertex id of the migration record or the lock, the ids are hashed in the spaces of int64 vid type.
+// The vid type must have been resolved by resolveVIDType.
+func (vm *VersionedMigrator) vid(id string) clause.Expr {
+	if *vm.intVID {
+		return clause.Expr{Str: "hash(" + strconv.Quote(id) + ")"}
+	}
+	return clause.Expr{Str: strconv.Quote(id)}
+}
+
+func (vm *VersionedMigrator) resolveVIDType() error {
+	if vm.intVID != nil {
+		return nil
+	}
+	space, err := vm.migrator.DescSpace(vm.migrator.db.SpaceName())
+	if err != nil {
+		return err
+	}
+	intVID := strings.EqualFold(space.VIDType, "INT64")
+	vm.intVID = &intVID
+	return nil
+}
@@ -0,0 +1,92 @@
+package norm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMigrations(t *testing.T) {
+	up := func(db *DB) error { return nil }
+	tests := []struct {
+		migrations []*Migration
+		wantErr    bool
+	}{
+		{migrations: nil},
+		{migrations: []*Migration{{ID: "1", Up: up}, {ID: "2", Up: up}}},
+		{migrations: []*Migration{{ID: "", Up: up}}, wantErr: true},
+		{migrations: []*Migration{{ID: "1"}}, wantErr: true},
+		{migrations: []*Migration{{ID: "1", Up: up}, {ID: "2", Up: up}, {ID: "1", Up: up}}, wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			err := validateMigrations(tt.migrations)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidValue)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSelectRollbacks(t *testing.T) {
+	up := func(db *DB) error { return nil }
+	migrations := []*Migration{
+		{ID: "1", Up: up, Down: up},
+		{ID: "2", Up: up},
+		{ID: "3", Up: up, Down: up},
+		{ID: "4", Up: up, Down: up},
+		{ID: "5", Up: up, Down: up},
+	}
+	tests := []struct {
+		applied []string
+		n       int
+		want    []string
+		wantErr bool
+	}{
+		{applied: []string{"1", "2", "3", "4", "5"}, n: 1, want: []string{"5"}},
+		{applied: []string{"1", "2", "3", "4", "5"}, n: 3, want: []string{"5", "4", "3"}},
+		{applied: []string{"1", "3", "5"}, n: 2, want: []string{"5", "3"}},
+		{applied: []string{"1", "3"}, n: 10, want: []string{"3", "1"}},
+		{applied: []string{}, n: 2, want: []string{}},
+		{applied: []string{"1", "2", "3"}, n: 3, wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			applied := make(map[string]time.Time, len(tt.applied))
+			for _, id := range tt.applied {
+				applied[id] = time.Now()
+			}
+			rollbacks, err := selectRollbacks(migrations, applied, tt.n)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidValue)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			ids := make([]string, 0, len(rollbacks))
+			for _, migration := range rollbacks {
+				ids = append(ids, migration.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestRollbackInvalidCount(t *testing.T) {
+	vm := (&Migrator{}).Versioned(&Migration{ID: "1", Up: func(db *DB) error { return nil }})
+	assert.ErrorIs(t, vm.Rollback(0), ErrInvalidValue)
+	assert.ErrorIs(t, vm.Rollback(-1), ErrInvalidValue)
+}
+
+func TestMigrationVID(t *testing.T) {
+	intVID, strVID := true, false
+	vm := &VersionedMigrator{intVID: &intVID}
+	assert.Equal(t, `hash("norm_migration_lock")`, vm.vid(migrationLockVID).Str)
+	vm.intVID = &strVID
+	assert.Equal(t, `"202401010001_init"`, vm.vid("202401010001_init").Str)
+}
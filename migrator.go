@@ -1,15 +1,16 @@
 package norm
 
 import (
-	"reflect"
-	"strings"
+	"context"
+	"errors"
+	"time"
 
 	"github.com/haysons/norm/clause"
-	"github.com/haysons/norm/resolver"
 )
 
 type Migrator struct {
-	db *DB
+	db      *DB
+	options migrateOptions
 }
 
 // Migrator creates a new Migrator instance based on the current DB object
@@ -17,6 +18,11 @@ func (db *DB) Migrator() *Migrator {
 	return &Migrator{db: db}
 }
 
+// WithContext returns a Migrator whose statements are executed with the given context
+func (m *Migrator) WithContext(ctx context.Context) *Migrator {
+	return &Migrator{db: m.db.withContext(ctx), options: m.options}
+}
+
 // NewMigrator creates a new Migrator instance based on the specified DB object
 func NewMigrator(db *DB) *Migrator {
 	return &Migrator{db: db}
@@ -25,226 +31,53 @@ func NewMigrator(db *DB) *Migrator {
 // AutoMigrateVertexes automatically migrates all tags associated with the given vertices
 // in the current graph space. If a tag does not exist, it will be created.
 // If the tag exists, each property will be checked for changes.
-// New properties or changed types, null constraints, or default values will trigger ALTER operations.
-// For safety, this method does not delete any existing tags or their properties.
+// New properties or changed types, null constraints and default values will trigger ALTER operations, while the
+// changed comments and TTL are only reported by PlanVertexes.
+// For safety, this method does not delete any existing tags or their properties, unless the Migrator
+// is created by WithOptions with the destructive options, such as WithDropUnknownProps.
 //
 // In addition, if index definitions are declared in the vertex struct and the corresponding
 // indexes do not exist in the current graph space, they will be created.
 //
 // Note: Index creation is asynchronous in NebulaGraph, so newly created indexes cannot
 // be immediately rebuilt within this method. It is recommended to call RebuildVertexTagIndexes
-// manually after migration to ensure indexes are properly built.
+// manually after migration and wait for the returned job to ensure indexes are properly built.
 //
-// For safety reasons, existing indexes will not be dropped.
+// For safety reasons, existing indexes will not be dropped unless WithDropUnknownIndexes or
+// WithRecreateChangedIndexes is given.
+//
+// The statements to be executed can be reviewed beforehand with PlanVertexes.
 func (m *Migrator) AutoMigrateVertexes(vertexes ...any) error {
-	for _, vertex := range vertexes {
-		vertexSchema, err := resolver.ParseVertex(reflect.TypeOf(vertex))
-		if err != nil {
-			return err
-		}
-		if err = m.autoMigrateVertex(vertexSchema); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// autoMigrateVertex handles the tag migration process for a single vertex.
-// It creates new tags if they do not exist, or alters existing ones.
-func (m *Migrator) autoMigrateVertex(vertex *resolver.VertexSchema) error {
-	for _, tag := range vertex.GetTags() {
-		hasTag, err := m.HasVertexTag(tag.TagName)
-		if err != nil {
-			return err
-		}
-		if !hasTag {
-			// Create the tag if it doesn't exist
-			err = m.CreateVertexTags(tag, true)
-		} else {
-			// Otherwise, apply ALTER operations to update the tag
-			err = m.autoAlterVertexTags(tag)
-		}
-		if err != nil {
-			return err
-		}
-		if err = m.autoCreateTagIndexes(tag); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// autoAlterVertexTags compares the current tag schema with the one in the database,
-// and generates ALTER operations for any property additions or changes.
-func (m *Migrator) autoAlterVertexTags(tag *resolver.VertexTag) error {
-	tagProps, err := m.DescVertexTag(tag.TagName)
+	plan, err := m.PlanVertexes(vertexes...)
 	if err != nil {
 		return err
 	}
-	propsExist := make(map[string]*PropDesc)
-	for _, tagProp := range tagProps {
-		propsExist[tagProp.Field] = tagProp
-	}
-	alterOp := clause.AlterOperate{}
-	for _, propNew := range tag.GetProps() {
-		// Add the property if it does not exist
-		propExist, ok := propsExist[propNew.Name]
-		if !ok {
-			alterOp.AddProps = append(alterOp.AddProps, propNew.Name)
-			continue
-		}
-		// Apply change if the property differs from existing definition
-		if m.isPropChanged(propExist, propNew) {
-			alterOp.ChangeProps = append(alterOp.ChangeProps, propNew.Name)
-		}
-	}
-	if len(alterOp.AddProps) == 0 && len(alterOp.ChangeProps) == 0 {
-		return nil
-	}
-	return m.AlterVertexTag(tag, alterOp)
-}
-
-func (m *Migrator) autoCreateTagIndexes(tag *resolver.VertexTag) error {
-	indexes := tag.GetIndexes()
-	for _, index := range indexes {
-		hasIndex, err := m.HasVertexTagIndex(index.Name)
-		if err != nil {
-			return err
-		}
-		if hasIndex {
-			continue
-		}
-		if err = m.CreateVertexTagsIndex(index, true); err != nil {
-			return err
-		}
-	}
-	return nil
+	return m.Apply(plan)
 }
 
 // AutoMigrateEdges automatically migrates edge schemas.
 // If the specified edge does not exist in the current graph space, it will be created.
 // If it already exists, each property will be compared to determine whether updates are needed.
-// For safety, this method will not delete existing edges or edge properties.
+// For safety, this method will not delete existing edges or edge properties, unless the Migrator
+// is created by WithOptions with the destructive options, such as WithDropUnknownProps.
 //
 // In addition, if index definitions are declared in the edge struct and the corresponding
 // indexes do not exist in the current graph space, they will be created.
 //
 // Note: Index creation is asynchronous in NebulaGraph, so newly created indexes cannot
 // be immediately rebuilt within this method. It is recommended to call RebuildEdgeIndexes
-// manually after migration to ensure indexes are properly built.
+// manually after migration and wait for the returned job to ensure indexes are properly built.
+//
+// For safety reasons, existing edges will never be dropped, and their indexes will not be dropped unless
+// WithDropUnknownIndexes or WithRecreateChangedIndexes is given.
 //
-// For safety reasons, existing edges or their properties and indexes will not be dropped.
+// The statements to be executed can be reviewed beforehand with PlanEdges.
 func (m *Migrator) AutoMigrateEdges(edges ...any) error {
-	for _, edge := range edges {
-		edgeSchema, err := resolver.ParseEdge(reflect.TypeOf(edge))
-		if err != nil {
-			return err
-		}
-		hasEdge, err := m.HasEdge(edgeSchema.GetTypeName())
-		if err != nil {
-			return err
-		}
-		if !hasEdge {
-			// Create the edge if it doesn't exist
-			err = m.CreateEdge(edgeSchema, true)
-		} else {
-			// Otherwise, apply ALTER operations to update the edge
-			err = m.autoAlterEdge(edgeSchema)
-		}
-		if err != nil {
-			return err
-		}
-		if err = m.autoCreateEdgeIndexes(edgeSchema); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// autoAlterEdge automatically applies property changes to an existing edge.
-// It compares the current edge schema with the existing one in the space,
-// and constructs an ALTER operation for any new or changed properties.
-func (m *Migrator) autoAlterEdge(edge *resolver.EdgeSchema) error {
-	edgeProps, err := m.DescEdge(edge.GetTypeName())
+	plan, err := m.PlanEdges(edges...)
 	if err != nil {
 		return err
 	}
-	propsExist := make(map[string]*PropDesc)
-	for _, edgeProp := range edgeProps {
-		propsExist[edgeProp.Field] = edgeProp
-	}
-	alterOp := clause.AlterOperate{}
-	for _, propNew := range edge.GetProps() {
-		// Add the property if it does not exist
-		propExist, ok := propsExist[propNew.Name]
-		if !ok {
-			alterOp.AddProps = append(alterOp.AddProps, propNew.Name)
-			continue
-		}
-		// Apply change if the property differs from existing definition
-		if m.isPropChanged(propExist, propNew) {
-			alterOp.ChangeProps = append(alterOp.ChangeProps, propNew.Name)
-		}
-	}
-	if len(alterOp.AddProps) == 0 && len(alterOp.ChangeProps) == 0 {
-		return nil
-	}
-	return m.AlterEdge(edge, alterOp)
-}
-
-func (m *Migrator) autoCreateEdgeIndexes(edge *resolver.EdgeSchema) error {
-	indexes := edge.GetIndexes()
-	for _, index := range indexes {
-		hasIndex, err := m.HasEdgeIndex(index.Name)
-		if err != nil {
-			return err
-		}
-		if hasIndex {
-			continue
-		}
-		if err = m.CreateEdgeIndex(index, true); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// isPropChanged determines whether a property definition has changed
-// by comparing type, nullability, and default value.
-func (m *Migrator) isPropChanged(propExist *PropDesc, propNew *resolver.Prop) bool {
-	propType := func(t string) string {
-		t = strings.ToLower(t)
-		// "int" is treated as an alias for "int64"
-		if t == "int" {
-			t = "int64"
-		}
-		return t
-	}
-
-	notNull := func(s string) bool {
-		if strings.ToLower(s) == "yes" {
-			return false
-		}
-		return true
-	}
-
-	defaultValue := func(s string) string {
-		if s == "_EMPTY_" {
-			return ""
-		}
-		if s == "" {
-			return "''"
-		}
-		return s
-	}
-
-	if propType(propNew.DataType) != propType(propExist.Type) ||
-		propNew.NotNull != notNull(propExist.Null) ||
-		propNew.Default != defaultValue(propExist.Default) {
-		return true
-	}
-
-	return false
+	return m.Apply(plan)
 }
 
 // HasVertexTag checks whether a given tag exists in the current graph space.
@@ -383,12 +216,13 @@ func (m *Migrator) CreateVertexTagsIndex(vertex any, ifNotExists ...bool) error
 	return tx.Exec()
 }
 
-// RebuildVertexTagIndexes rebuilds the specified vertex tag indexes.
+// RebuildVertexTagIndexes rebuilds the specified vertex tag indexes. The indexes are rebuilt by a job in the
+// background, the returned job can be used to wait for it to finish.
 // see more information on the method of the same name in statement.Statement
-func (m *Migrator) RebuildVertexTagIndexes(indexNames ...string) error {
+func (m *Migrator) RebuildVertexTagIndexes(indexNames ...string) (*Job, error) {
 	tx := m.db.getInstance()
 	tx.Statement.RebuildVertexTagIndexes(indexNames...)
-	return tx.Exec()
+	return newJob(m.db, tx)
 }
 
 // DropVertexTagIndex drops a vertex tag index by its name.
@@ -423,12 +257,13 @@ func (m *Migrator) CreateEdgeIndex(edge any, ifNotExists ...bool) error {
 	return tx.Exec()
 }
 
-// RebuildEdgeIndexes rebuilds one or more edge indexes by their names.
+// RebuildEdgeIndexes rebuilds one or more edge indexes by their names. The indexes are rebuilt by a job in the
+// background, the returned job can be used to wait for it to finish.
 // see more information on the method of the same name in statement.Statement
-func (m *Migrator) RebuildEdgeIndexes(indexNames ...string) error {
+func (m *Migrator) RebuildEdgeIndexes(indexNames ...string) (*Job, error) {
 	tx := m.db.getInstance()
 	tx.Statement.RebuildEdgeIndexes(indexNames...)
-	return tx.Exec()
+	return newJob(m.db, tx)
 }
 
 // DropEdgeIndex drops an edge index by name.
@@ -438,3 +273,102 @@ func (m *Migrator) DropEdgeIndex(indexName string, ifExists ...bool) error {
 	tx.Statement.DropEdgeIndex(indexName, ifExists...)
 	return tx.Exec()
 }
+
+const (
+	spaceReadyInterval = time.Second
+	spaceReadyTimeout  = time.Minute
+)
+
+// SpaceDesc is the description of a graph space returned by DESCRIBE SPACE
+type SpaceDesc struct {
+	ID            int64  `norm:"col:ID"`
+	Name          string `norm:"col:Name"`
+	PartitionNum  int    `norm:"col:Partition Number"`
+	ReplicaFactor int    `norm:"col:Replica Factor"`
+	Charset       string `norm:"col:Charset"`
+	Collate       string `norm:"col:Collate"`
+	VIDType       string `norm:"col:Vid Type"`
+	Comment       string `norm:"col:Comment"`
+}
+
+// HasSpace checks whether the graph space exists.
+func (m *Migrator) HasSpace(spaceName string) (bool, error) {
+	spaces := make([]string, 0)
+	err := m.db.Raw("SHOW SPACES").
+		FindCol("Name", &spaces)
+	if err != nil {
+		return false, err
+	}
+	for _, space := range spaces {
+		if space == spaceName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DescSpace returns the description of the graph space, such as the partition number and the vid type.
+func (m *Migrator) DescSpace(spaceName string) (*SpaceDesc, error) {
+	space := new(SpaceDesc)
+	err := m.db.Raw("DESCRIBE SPACE " + spaceName).
+		Take(space)
+	if err != nil {
+		return nil, err
+	}
+	return space, nil
+}
+
+// CreateSpace creates a graph space and waits until it is ready to use, see WaitSpaceReady.
+// see more information on the method of the same name in statement.Statement
+//
+// Note: the DB must be opened with an existing space in Config.SpaceName, the new space is created from it.
+func (m *Migrator) CreateSpace(spaceName string, vidType string, opts ...clause.SpaceOption) error {
+	tx := m.db.getInstance()
+	tx.Statement.CreateSpace(spaceName, vidType, opts...)
+	if err := tx.Exec(); err != nil {
+		return err
+	}
+	return m.WaitSpaceReady(spaceName)
+}
+
+// CloneSpace creates a graph space with the same schema as the source space and waits until it is ready to use.
+// see more information on the method of the same name in statement.Statement
+func (m *Migrator) CloneSpace(spaceName string, sourceSpaceName string, ifNotExists ...bool) error {
+	tx := m.db.getInstance()
+	tx.Statement.CloneSpace(spaceName, sourceSpaceName, ifNotExists...)
+	if err := tx.Exec(); err != nil {
+		return err
+	}
+	return m.WaitSpaceReady(spaceName)
+}
+
+// DropSpace drops a graph space and all the data in it.
+// see more information on the method of the same name in statement.Statement
+func (m *Migrator) DropSpace(spaceName string, ifExists ...bool) error {
+	tx := m.db.getInstance()
+	tx.Statement.DropSpace(spaceName, ifExists...)
+	return tx.Exec()
+}
+
+// WaitSpaceReady waits until the graph space can be used. Space creation takes effect asynchronously in nebula graph,
+// the new space is not visible to the graph service until the next heartbeat, which is 10 seconds by default. The space
+// is polled every second until it is ready, the context of the Migrator or one minute at most.
+func (m *Migrator) WaitSpaceReady(spaceName string) error {
+	ctx := m.db.Context()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, spaceReadyTimeout)
+		defer cancel()
+	}
+	db := m.db.withContext(ctx)
+	for {
+		// the session pool switches the session back to the default space after the statement is executed
+		err := db.Raw("USE " + spaceName + "; SHOW TAGS").Exec()
+		if !errors.Is(err, ErrSpaceNotFound) {
+			return err
+		}
+		if err = sleepContext(ctx, spaceReadyInterval); err != nil {
+			return err
+		}
+	}
+}
@@ -7,12 +7,36 @@ import (
 
 // Raw exec nGQL statements natively
 // see more information on the method of the same name in statement.Statement
-func (db *DB) Raw(raw string) (tx *DB) {
+func (db *DB) Raw(raw string, args ...any) (tx *DB) {
 	tx = db.getInstance()
-	tx.Statement.Raw(raw)
+	tx.Statement.Raw(raw, args...)
 	return tx
 }
 
+// Parameterized sends the arguments of the statement to the server as query parameters
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Parameterized() (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Parameterized()
+	return
+}
+
+// Unscoped disables the filter of the soft deleted data, the queries return the soft deleted data as well
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Unscoped() (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Unscoped()
+	return
+}
+
+// HardDelete deletes the data even if the tags or edges are declared with a soft delete prop
+// see more information on the method of the same name in statement.Statement
+func (db *DB) HardDelete() (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.HardDelete()
+	return
+}
+
 // Go generate go clause
 // see more information on the method of the same name in statement.Statement
 func (db *DB) Go(step ...int) (tx *DB) {
@@ -141,6 +165,78 @@ func (db *DB) GetSubgraph(steps int, withProp ...bool) (tx *DB) {
 	return
 }
 
+// FindPath generate find path clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) FindPath(pathType string, withProp ...bool) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.FindPath(pathType, withProp...)
+	return
+}
+
+// To generate to clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) To(vid any) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.To(vid)
+	return
+}
+
+// Upto generate upto clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Upto(steps int) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Upto(steps)
+	return
+}
+
+// Match generate openCypher match clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Match(patterns ...any) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Match(patterns...)
+	return
+}
+
+// OptionalMatch generate openCypher optional match clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) OptionalMatch(patterns ...any) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.OptionalMatch(patterns...)
+	return
+}
+
+// With generate openCypher with clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) With(expr string, distinct ...bool) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.With(expr, distinct...)
+	return
+}
+
+// Unwind generate openCypher unwind clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Unwind(expr string, alias string) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Unwind(expr, alias)
+	return
+}
+
+// Return generate openCypher return clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Return(expr string, distinct ...bool) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Return(expr, distinct...)
+	return
+}
+
+// Skip generate openCypher skip clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Skip(offset int) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Skip(offset)
+	return
+}
+
 // In generate in clause
 // see more information on the method of the same name in statement.Statement
 func (db *DB) In(edgeTypes ...string) (tx *DB) {
@@ -253,6 +349,8 @@ func (db *DB) Debug() (tx *DB) {
 		Statement:   tx.Statement,
 		conf:        &confNew,
 		sessionPool: tx.sessionPool,
+		ctx:         tx.ctx,
+		callbacks:   tx.callbacks,
 		clone:       1,
 	}
 }
@@ -1,6 +1,7 @@
 package norm
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
@@ -22,12 +23,16 @@ import (
 //     In most cases, a single DB instance is sufficient for the application.
 //   - statement.Statement is NOT concurrency-safe.
 //     Do not build nGQL statements concurrently using the same Statement instance.
-//   - Embedded fields in struct definitions are NOT supported.
-//     Avoid using embedded fields when defining vertex/edge structs.
+//   - The fields of embedded structs, both values and pointers, are promoted into the owning tag, edge or record.
+//     A named struct field tagged with `norm:"embedded;prefix:x_"` is promoted as well, with prefixed prop names.
 type DB struct {
 	Statement   *statement.Statement
 	conf        *Config
 	sessionPool *nebula.SessionPool
+	ctx         context.Context
+	callbacks   *Callbacks
+	resultSet   *nebula.ResultSet
+	idempotent  bool
 	clone       int
 }
 
@@ -56,6 +61,17 @@ func Open(conf *Config, opts ...ConfigOption) (*DB, error) {
 		conf.logger = logger.Default
 	}
 
+	if conf.retryPolicy == nil {
+		policy := DefaultRetryPolicy
+		conf.retryPolicy = &policy
+	}
+
+	softDeletes, err := statement.ParseSoftDeletes(conf.softDeleteModels...)
+	if err != nil {
+		return nil, err
+	}
+	conf.softDeletes = softDeletes
+
 	hostAddr, err := parseServerAddr(conf.Addresses)
 	if err != nil {
 		return nil, err
@@ -73,6 +89,7 @@ func Open(conf *Config, opts ...ConfigOption) (*DB, error) {
 		Statement:   statement.New(),
 		conf:        conf,
 		sessionPool: pool,
+		callbacks:   newCallbacks(),
 		clone:       1, // when clone is 1, the Statement object will be copied to ensure that the same singleton build statement does not affect each other.
 	}
 	return db, nil
@@ -115,24 +132,98 @@ func parseSessionOptions(conf *Config) []nebula.SessionPoolConfOption {
 	return poolOptions
 }
 
+// WithContext returns a DB that executes statements with the given context. The context is passed to the logger,
+// and once it is canceled or its deadline is exceeded, the execution stops waiting for the result and returns ctx.Err().
+//
+// When called on a DB that has not started building a statement, such as the one returned by Open, the returned DB can
+// be reused to build multiple statements just like the original one.
+func (db *DB) WithContext(ctx context.Context) *DB {
+	if db.clone > 0 {
+		return &DB{
+			Statement:   statement.New(),
+			conf:        db.conf,
+			sessionPool: db.sessionPool,
+			ctx:         ctx,
+			callbacks:   db.callbacks,
+			clone:       1,
+		}
+	}
+	db.ctx = ctx
+	return db
+}
+
 func (db *DB) getInstance() *DB {
 	if db.clone > 0 {
-		tx := &DB{conf: db.conf, sessionPool: db.sessionPool, clone: 0}
-		tx.Statement = statement.New()
+		tx := &DB{conf: db.conf, sessionPool: db.sessionPool, ctx: db.ctx, callbacks: db.callbacks, clone: 0}
+		tx.Statement = db.newStatement()
 		return tx
 	}
 	return db
 }
 
+// withContext returns a new DB executing the statements with the given context, unlike WithContext the DB itself is
+// never changed, so that a context canceled afterward does not affect it
+func (db *DB) withContext(ctx context.Context) *DB {
+	tx := db.session()
+	tx.ctx = ctx
+	tx.clone = 1
+	return tx
+}
+
 func (db *DB) session() *DB {
 	return &DB{
-		Statement:   statement.New(),
+		Statement:   db.newStatement(),
 		conf:        db.conf,
 		sessionPool: db.sessionPool,
+		ctx:         db.ctx,
+		callbacks:   db.callbacks,
 		clone:       0,
 	}
 }
 
+// Callback returns the callback registry of the DB, which is shared by all the DB instances derived from it.
+// Callbacks are registered for each kind of operation and are called in order around the build and execution of
+// the statement, see CallbackBuild and CallbackExecute.
+func (db *DB) Callback() *Callbacks {
+	return db.callbacks
+}
+
+// ResultSet returns the result set of the executed statement, it is mainly used by the callbacks registered after
+// CallbackExecute
+func (db *DB) ResultSet() *nebula.ResultSet {
+	return db.resultSet
+}
+
+// SetResultSet sets the result set of the statement, it allows a callback replacing CallbackExecute to provide the
+// result by itself, such as reading it from a cache
+func (db *DB) SetResultSet(resultSet *nebula.ResultSet) {
+	db.resultSet = resultSet
+}
+
+// SpaceName returns the name of the graph space the DB connects to
+func (db *DB) SpaceName() string {
+	return db.conf.SpaceName
+}
+
+// Context returns the context the statements are executed with, context.Background is returned if it is not set
+func (db *DB) Context() context.Context {
+	if db.ctx == nil {
+		return context.Background()
+	}
+	return db.ctx
+}
+
+func (db *DB) newStatement() *statement.Statement {
+	stmt := statement.New()
+	if db.conf.parameterized {
+		stmt.Parameterized()
+	}
+	if len(db.conf.softDeletes) > 0 {
+		stmt.SoftDeletes(db.conf.softDeletes)
+	}
+	return stmt
+}
+
 func (db *DB) Close() error {
 	db.sessionPool.Close()
 	return nil
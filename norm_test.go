@@ -0,0 +1,87 @@
+package norm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/haysons/norm/statement"
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
+)
+
+// newTestDB returns a DB that is not connected to the graph service, each statement is answered by respond, which
+// receives the built nGQL
+func newTestDB(t *testing.T, respond func(nGQL string) *nebula.ResultSet) *DB {
+	db := &DB{Statement: statement.New(), conf: &Config{SpaceName: "test"}, callbacks: newCallbacks(), clone: 1}
+	for _, processor := range db.callbacks.processors {
+		err := processor.Replace(CallbackExecute, func(tx *DB) error {
+			nGQL, err := tx.NGQL()
+			if err != nil {
+				return err
+			}
+			tx.SetResultSet(respond(nGQL))
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+// genDataSet returns a succeeded result set of the given columns and rows, the values of the rows may be string, int,
+// bool, []string or nil
+func genDataSet(t *testing.T, cols []string, rows ...[]any) *nebula.ResultSet {
+	dataSet := &nebulattypes.DataSet{}
+	for _, col := range cols {
+		dataSet.ColumnNames = append(dataSet.ColumnNames, []byte(col))
+	}
+	for _, row := range rows {
+		values := make([]*nebulattypes.Value, 0, len(row))
+		for _, v := range row {
+			values = append(values, genValue(t, v))
+		}
+		dataSet.Rows = append(dataSet.Rows, &nebulattypes.Row{Values: values})
+	}
+	res, err := nebula.GenResultSet(&graph.ExecutionResponse{ErrorCode: nebulattypes.ErrorCode_SUCCEEDED, Data: dataSet})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func genValue(t *testing.T, v any) *nebulattypes.Value {
+	switch v := v.(type) {
+	case nil:
+		null := nebulattypes.NullType___NULL__
+		return &nebulattypes.Value{NVal: &null}
+	case string:
+		return &nebulattypes.Value{SVal: []byte(v)}
+	case int:
+		i := int64(v)
+		return &nebulattypes.Value{IVal: &i}
+	case bool:
+		return &nebulattypes.Value{BVal: &v}
+	case []string:
+		list := &nebulattypes.NList{}
+		for _, s := range v {
+			list.Values = append(list.Values, genValue(t, s))
+		}
+		return &nebulattypes.Value{LVal: list}
+	default:
+		t.Fatalf("unsupported value %T", v)
+		return nil
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	db := &DB{Statement: statement.New(), conf: &Config{}, ctx: context.Background()}
+	ctx, cancel := context.WithCancel(context.Background())
+	tx := db.withContext(ctx)
+	cancel()
+	assert.NoError(t, db.Context().Err())
+	assert.ErrorIs(t, tx.Context().Err(), context.Canceled)
+	assert.NotSame(t, tx.getInstance(), tx)
+}
@@ -0,0 +1,172 @@
+// Package otel instruments norm with OpenTelemetry. Every statement executed by an instrumented DB is traced by a
+// client span, and its duration and errors are recorded by metrics.
+//
+//	db, err := norm.Open(conf)
+//	if err != nil {
+//		return err
+//	}
+//	if err = otel.Instrument(db); err != nil {
+//		return err
+//	}
+package otel
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/haysons/norm"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/haysons/norm/otel"
+
+	dbSystem = "nebulagraph"
+)
+
+// attribute keys following the OpenTelemetry semantic conventions of database clients
+var (
+	dbSystemKey         = attribute.Key("db.system")
+	dbNamespaceKey      = attribute.Key("db.namespace")
+	dbOperationNameKey  = attribute.Key("db.operation.name")
+	dbQueryTextKey      = attribute.Key("db.query.text")
+	dbResponseStatusKey = attribute.Key("db.response.status_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	attrs          []attribute.KeyValue
+	sanitizer      func(nGQL string) string
+}
+
+// Option configures the instrumentation
+type Option func(*config)
+
+// WithTracerProvider specifies the tracer provider, the global one is used by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider specifies the meter provider, the global one is used by default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithAttributes adds attributes to all the spans and metrics
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return func(c *config) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
+// WithSanitizer customizes how the statement is sanitized before being recorded as db.query.text, by default the
+// literals are replaced by '?', returning an empty string omits the attribute
+func WithSanitizer(sanitizer func(nGQL string) string) Option {
+	return func(c *config) {
+		c.sanitizer = sanitizer
+	}
+}
+
+type instrumentation struct {
+	config
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// Instrument wraps the execution of every kind of operation of the DB, so that each statement is traced by a client
+// span and recorded by the metrics db.client.operation.duration and db.client.operation.errors. The callbacks are
+// shared by all the DB instances derived from the given one.
+func Instrument(db *norm.DB, opts ...Option) error {
+	inst := &instrumentation{
+		config: config{
+			tracerProvider: otel.GetTracerProvider(),
+			meterProvider:  otel.GetMeterProvider(),
+			sanitizer:      Sanitize,
+		},
+	}
+	for _, opt := range opts {
+		opt(&inst.config)
+	}
+	inst.tracer = inst.tracerProvider.Tracer(instrumentationName)
+	meter := inst.meterProvider.Meter(instrumentationName)
+	var err error
+	inst.duration, err = meter.Float64Histogram("db.client.operation.duration",
+		metric.WithDescription("Duration of database client operations."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+	inst.errors, err = meter.Int64Counter("db.client.operation.errors",
+		metric.WithDescription("Number of failed database client operations."),
+		metric.WithUnit("{error}"))
+	if err != nil {
+		return err
+	}
+
+	cbs := db.Callback()
+	for _, processor := range []*norm.CallbackProcessor{cbs.Query(), cbs.Insert(), cbs.Update(), cbs.Delete(), cbs.Migrate()} {
+		execute := processor.Get(norm.CallbackExecute)
+		if execute == nil {
+			continue
+		}
+		if err = processor.Replace(norm.CallbackExecute, inst.wrap(execute)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *instrumentation) wrap(execute norm.CallbackFunc) norm.CallbackFunc {
+	return func(db *norm.DB) error {
+		operation := string(db.Operation())
+		attrs := make([]attribute.KeyValue, 0, len(inst.attrs)+3)
+		attrs = append(attrs, dbSystemKey.String(dbSystem), dbOperationNameKey.String(operation))
+		spaceName := db.SpaceName()
+		if spaceName != "" {
+			attrs = append(attrs, dbNamespaceKey.String(spaceName))
+		}
+		attrs = append(attrs, inst.attrs...)
+
+		spanName := operation
+		if spaceName != "" {
+			spanName += " " + spaceName
+		}
+		ctx, span := inst.tracer.Start(db.Context(), spanName,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...))
+		defer span.End()
+		if nGQL, err := db.Statement.NGQL(); err == nil && inst.sanitizer != nil {
+			if text := inst.sanitizer(nGQL); text != "" {
+				span.SetAttributes(dbQueryTextKey.String(text))
+			}
+		}
+
+		begin := time.Now()
+		err := execute(db.WithContext(ctx))
+		inst.duration.Record(ctx, time.Since(begin).Seconds(), metric.WithAttributes(attrs...))
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			inst.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+			return err
+		}
+		if res := db.ResultSet(); res != nil && !res.IsSucceed() {
+			code := strconv.Itoa(int(res.GetErrorCode()))
+			span.SetAttributes(dbResponseStatusKey.String(code))
+			span.SetStatus(codes.Error, res.GetErrorMsg())
+			inst.errors.Add(ctx, 1, metric.WithAttributes(append(attrs, dbResponseStatusKey.String(code))...))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package otel
+
+import "strings"
+
+// Sanitize replaces the string and number literals of the statement with '?', so that the values in the statement
+// are not recorded. Identifiers, parameters such as $p1 and the hops of path patterns such as *1..3 are kept.
+//
+// LOOKUP ON player WHERE player.name == "Tim Duncan" AND player.age > 40 YIELD id(vertex)
+// becomes
+// LOOKUP ON player WHERE player.name == ? AND player.age > ? YIELD id(vertex)
+func Sanitize(nGQL string) string {
+	var b strings.Builder
+	b.Grow(len(nGQL))
+	for i := 0; i < len(nGQL); {
+		c := nGQL[i]
+		switch {
+		case c == '"' || c == '\'':
+			// string literal, backslash escapes the next character
+			j := i + 1
+			for j < len(nGQL) && nGQL[j] != c {
+				if nGQL[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			b.WriteByte('?')
+			i = j + 1
+		case c == '`':
+			// quoted identifier, written as it is
+			j := strings.IndexByte(nGQL[i+1:], '`')
+			if j < 0 {
+				b.WriteString(nGQL[i:])
+				return b.String()
+			}
+			b.WriteString(nGQL[i : i+j+2])
+			i += j + 2
+		case isDigit(c) && (i == 0 || !isIdentByte(nGQL[i-1]) && nGQL[i-1] != '*' && nGQL[i-1] != '.'):
+			j := i + 1
+			for j < len(nGQL) && isDigit(nGQL[j]) {
+				j++
+			}
+			if j+1 < len(nGQL) && nGQL[j] == '.' && isDigit(nGQL[j+1]) {
+				j++
+				for j < len(nGQL) && isDigit(nGQL[j]) {
+					j++
+				}
+			}
+			b.WriteByte('?')
+			i = j
+		case isIdentByte(c):
+			// identifiers may contain digits, such as player100 or $p1
+			j := i + 1
+			for j < len(nGQL) && isIdentByte(nGQL[j]) {
+				j++
+			}
+			b.WriteString(nGQL[i:j])
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
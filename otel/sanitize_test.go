@@ -0,0 +1,41 @@
+package otel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		nGQL string
+		want string
+	}{
+		{
+			nGQL: `LOOKUP ON player WHERE player.name == "Tim Duncan" AND player.age > 40 YIELD id(vertex);`,
+			want: `LOOKUP ON player WHERE player.name == ? AND player.age > ? YIELD id(vertex);`,
+		},
+		{
+			nGQL: `GO 2 STEPS FROM "player100" OVER follow WHERE properties(edge).degree > 90.5 YIELD dst(edge) | LIMIT 10;`,
+			want: `GO ? STEPS FROM ? OVER follow WHERE properties(edge).degree > ? YIELD dst(edge) | LIMIT ?;`,
+		},
+		{
+			nGQL: `MATCH p = (v:player{name: 'Tim \'Duncan'})-[e:follow*1..3]->(v2) RETURN p;`,
+			want: `MATCH p = (v:player{name: ?})-[e:follow*1..3]->(v2) RETURN p;`,
+		},
+		{
+			nGQL: "LOOKUP ON player WHERE player.name == $p1 YIELD properties(vertex).`age1` AS age2;",
+			want: "LOOKUP ON player WHERE player.name == $p1 YIELD properties(vertex).`age1` AS age2;",
+		},
+		{
+			nGQL: `INSERT VERTEX player(name, age) VALUES "player1":("Kobe \"Bryant\"", -33);`,
+			want: `INSERT VERTEX player(name, age) VALUES ?:(?, -?);`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, Sanitize(tt.nGQL))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package norm
+
+import (
+	"fmt"
+
+	"github.com/haysons/norm/statement"
+)
+
+// PageInfo is the position of a page in the whole result, pages are numbered from 1
+type PageInfo struct {
+	Page    int
+	Size    int
+	Total   int64
+	HasNext bool
+}
+
+// Page is a page of the result along with its position, it is returned by ExecInterface.FindPage
+type Page[T any] struct {
+	Items []T
+	PageInfo
+}
+
+// Paginate executes the statement for the rows of the given page, which are assigned to dest, and counts the rows of
+// the whole result by appending | YIELD COUNT(*) to the same statement. The statement should be ordered for the pages
+// to be stable, and should not be limited by itself. It is intended for LOOKUP and GO statements, see
+// statement.Statement.Page and statement.Statement.Count.
+//
+//	players := make([]*Player, 0)
+//	info, err := db.Lookup("player").Where("player.age > ?", 30).
+//		Yield("id(vertex) AS vid, player.name AS name").OrderBy("$-.vid").
+//		Paginate(2, 20, &players)
+func (db *DB) Paginate(page int, size int, dest any) (*PageInfo, error) {
+	tx := db.getInstance()
+	if page < 1 || size < 1 {
+		return nil, fmt.Errorf("norm: %w, page and size must be positive", ErrInvalidValue)
+	}
+	if tx.Statement.IsRaw() {
+		return nil, fmt.Errorf("norm: %w, the statements written by Raw cannot be paginated", ErrInvalidValue)
+	}
+	info := &PageInfo{Page: page, Size: size}
+	totals := make([]int64, 0, 1)
+	if err := tx.withStatement(tx.Statement.Count()).FindCol(statement.CountCol, &totals); err != nil {
+		return nil, err
+	}
+	if len(totals) > 0 {
+		info.Total = totals[0]
+	}
+	offset := (page - 1) * size
+	if int64(offset) >= info.Total {
+		return info, nil
+	}
+	if err := tx.withStatement(tx.Statement.Page(size, offset)).Find(dest); err != nil {
+		return nil, err
+	}
+	info.HasNext = int64(offset+size) < info.Total
+	return info, nil
+}
+
+// withStatement returns a DB that executes the given statement, which is derived from the statement of db
+func (db *DB) withStatement(stmt *statement.Statement) *DB {
+	tx := db.session()
+	tx.Statement = stmt
+	return tx
+}
@@ -0,0 +1,148 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+type pageRecord struct {
+	VID string `norm:"col:vid"`
+}
+
+func TestPaginate(t *testing.T) {
+	lookup := "LOOKUP ON player YIELD id(vertex) AS vid | ORDER BY $-.vid"
+	count := lookup + " | YIELD COUNT(*) AS total;"
+	tests := []struct {
+		page     int
+		size     int
+		total    []any
+		pages    map[string][][]any
+		wantNGQL []string
+		want     []pageRecord
+		wantInfo *PageInfo
+		wantErr  error
+	}{
+		{
+			page:     1,
+			size:     2,
+			total:    []any{5},
+			pages:    map[string][][]any{lookup + " | LIMIT 2;": {{"p1"}, {"p2"}}},
+			wantNGQL: []string{count, lookup + " | LIMIT 2;"},
+			want:     []pageRecord{{VID: "p1"}, {VID: "p2"}},
+			wantInfo: &PageInfo{Page: 1, Size: 2, Total: 5, HasNext: true},
+		},
+		{
+			page:     3,
+			size:     2,
+			total:    []any{5},
+			pages:    map[string][][]any{lookup + " | LIMIT 4, 2;": {{"p5"}}},
+			wantNGQL: []string{count, lookup + " | LIMIT 4, 2;"},
+			want:     []pageRecord{{VID: "p5"}},
+			wantInfo: &PageInfo{Page: 3, Size: 2, Total: 5},
+		},
+		{
+			page:     2,
+			size:     2,
+			total:    []any{4},
+			pages:    map[string][][]any{lookup + " | LIMIT 2, 2;": {{"p3"}, {"p4"}}},
+			wantNGQL: []string{count, lookup + " | LIMIT 2, 2;"},
+			want:     []pageRecord{{VID: "p3"}, {VID: "p4"}},
+			wantInfo: &PageInfo{Page: 2, Size: 2, Total: 4},
+		},
+		{
+			page:     4,
+			size:     2,
+			total:    []any{5},
+			wantNGQL: []string{count},
+			want:     []pageRecord{},
+			wantInfo: &PageInfo{Page: 4, Size: 2, Total: 5},
+		},
+		{
+			page:     1,
+			size:     2,
+			wantNGQL: []string{count},
+			want:     []pageRecord{},
+			wantInfo: &PageInfo{Page: 1, Size: 2},
+		},
+		{
+			page:    0,
+			size:    2,
+			want:    []pageRecord{},
+			wantErr: ErrInvalidValue,
+		},
+		{
+			page:    1,
+			size:    0,
+			want:    []pageRecord{},
+			wantErr: ErrInvalidValue,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			var gotNGQL []string
+			db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+				gotNGQL = append(gotNGQL, nGQL)
+				if nGQL == count {
+					if tt.total == nil {
+						return genDataSet(t, []string{"total"})
+					}
+					return genDataSet(t, []string{"total"}, tt.total)
+				}
+				rows, ok := tt.pages[nGQL]
+				if !ok {
+					t.Fatalf("unexpected statement %s", nGQL)
+				}
+				return genDataSet(t, []string{"vid"}, rows...)
+			})
+			got := make([]pageRecord, 0)
+			info, err := db.Lookup("player").Yield("id(vertex) AS vid").OrderBy("$-.vid").Paginate(tt.page, tt.size, &got)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantInfo, info)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNGQL, gotNGQL)
+		})
+	}
+}
+
+func TestPaginateRaw(t *testing.T) {
+	db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+		t.Fatalf("unexpected statement %s", nGQL)
+		return nil
+	})
+	_, err := db.Raw("LOOKUP ON player YIELD id(vertex) AS vid").Paginate(1, 2, &[]pageRecord{})
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestFindPage(t *testing.T) {
+	lookup := "LOOKUP ON player YIELD id(vertex) AS vid | ORDER BY $-.vid"
+	var gotNGQL []string
+	db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+		gotNGQL = append(gotNGQL, nGQL)
+		switch nGQL {
+		case lookup + " | YIELD COUNT(*) AS total;":
+			return genDataSet(t, []string{"total"}, []any{3})
+		case lookup + " | LIMIT 2, 2;":
+			return genDataSet(t, []string{"vid"}, []any{"p3"})
+		}
+		t.Fatalf("unexpected statement %s", nGQL)
+		return nil
+	})
+	page, err := G[pageRecord](db).Lookup("player").Yield("id(vertex) AS vid").OrderBy("$-.vid").FindPage(context.Background(), 2, 2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []pageRecord{{VID: "p3"}}, page.Items)
+		assert.Equal(t, PageInfo{Page: 2, Size: 2, Total: 3}, page.PageInfo)
+	}
+	assert.Equal(t, []string{lookup + " | YIELD COUNT(*) AS total;", lookup + " | LIMIT 2, 2;"}, gotNGQL)
+
+	_, err = G[pageRecord](db).Lookup("player").Yield("id(vertex) AS vid").FindPage(context.Background(), 0, 2)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
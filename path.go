@@ -0,0 +1,16 @@
+package norm
+
+// Path is used to receive the path returned by FIND PATH, GET SUBGRAPH or MATCH statements, the nodes and relationships
+// of the path are assigned to the vertex type V and the edge type E respectively.
+//
+//	paths, err := norm.G[norm.Path[Player, Follow]](db).
+//		FindPath(clause.PathTypeShortest).From("player102").To("player100").Over("follow").
+//		Yield("path AS p").
+//		FindCol(ctx, "p")
+//
+// If the path contains vertexes or edges of different types, V or E can be defined as any, in which case the raw
+// *nebula.Node and *nebula.Relationship are assigned.
+type Path[V any, E any] struct {
+	Nodes         []V `norm:"path_nodes"`
+	Relationships []E `norm:"path_relationships"`
+}
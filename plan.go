@@ -0,0 +1,559 @@
+package norm
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/haysons/norm/clause"
+	"github.com/haysons/norm/resolver"
+	"github.com/haysons/norm/statement"
+)
+
+// SchemaKind is the kind of schema compared by the migration plan
+type SchemaKind string
+
+const (
+	SchemaKindTag  SchemaKind = "TAG"
+	SchemaKindEdge SchemaKind = "EDGE"
+)
+
+// MigrationPlan is the result of comparing the structs with the schemas in the current graph space, it holds the
+// differences of each tag or edge and the statements that would be executed to migrate them. The plan is returned
+// by PlanVertexes and PlanEdges, so that the changes can be reviewed before they are applied by Apply.
+type MigrationPlan struct {
+	Diffs        []*SchemaDiff
+	steps        []planStep
+	newStatement func() *statement.Statement
+}
+
+// planStep is one statement of the plan, it is built again when applied, so that the callbacks can still change it
+type planStep func(stmt *statement.Statement)
+
+// SchemaDiff is the difference between a tag or edge declared by the struct and the one in the graph space
+type SchemaDiff struct {
+	Kind SchemaKind
+	Name string
+	// Created is true if the schema does not exist and will be created
+	Created bool
+	// AddedProps are the props declared by the struct but not existing in the schema
+	AddedProps []string
+	// ChangedProps are the props whose type, null constraint, default value or comment is changed, the props whose
+	// comment is the only change are reported but not altered
+	ChangedProps []*PropChange
+	// OrphanedProps are the props existing in the schema but no longer declared by the struct
+	OrphanedProps []string
+	// TTL is not nil if the ttl col or the ttl duration is changed, it is reported but not altered, except that the ttl
+	// is removed before its col is dropped, see WithDropUnknownProps
+	TTL *TTLChange
+	// Comment is not nil if the comment of the tag or edge is changed, it is reported but not altered
+	Comment *CommentChange
+	// MissingIndexes are the indexes declared by the struct but not existing in the graph space
+	MissingIndexes []string
+	// ExtraIndexes are the indexes on the schema that are not declared by the struct
+	ExtraIndexes []string
+	// ChangedIndexes are the indexes whose columns differ from the ones declared by the struct
+	ChangedIndexes []string
+	// DroppedProps are the orphaned props that will be dropped, see WithDropUnknownProps
+	DroppedProps []string
+	// DroppedIndexes are the extra or changed indexes that will be dropped, the changed ones are created again, see
+	// WithDropUnknownIndexes and WithRecreateChangedIndexes
+	DroppedIndexes []string
+	// NGQL is the statements that would be executed to migrate the schema
+	NGQL []string
+}
+
+// Changed reports whether there is any difference between the struct and the schema
+func (d *SchemaDiff) Changed() bool {
+	return d.Created || len(d.AddedProps) > 0 || len(d.ChangedProps) > 0 || len(d.OrphanedProps) > 0 ||
+		d.TTL != nil || d.Comment != nil || len(d.MissingIndexes) > 0 || len(d.ExtraIndexes) > 0 || len(d.ChangedIndexes) > 0
+}
+
+// PropChange is the change of a prop, Old is the prop described by the graph space and New is the one declared by the struct
+type PropChange struct {
+	Name           string
+	Old            *PropDesc
+	New            *resolver.Prop
+	TypeChanged    bool
+	NullChanged    bool
+	DefaultChanged bool
+	CommentChanged bool
+}
+
+// TTLChange is the change of the ttl, an empty col means there is no ttl
+type TTLChange struct {
+	OldCol      string
+	OldDuration string
+	NewCol      string
+	NewDuration string
+}
+
+// CommentChange is the change of the comment of the tag or edge, an empty comment means there is no comment
+type CommentChange struct {
+	Old string
+	New string
+}
+
+// altered reports whether the prop is altered by the migration, a changed comment alone does not alter the prop
+func (c *PropChange) altered() bool {
+	return c.TypeChanged || c.NullChanged || c.DefaultChanged
+}
+
+// NGQL returns all the statements of the plan in the order they would be executed
+func (p *MigrationPlan) NGQL() []string {
+	nGQLs := make([]string, 0, len(p.steps))
+	for _, diff := range p.Diffs {
+		nGQLs = append(nGQLs, diff.NGQL...)
+	}
+	return nGQLs
+}
+
+// Empty reports whether the plan has no statement to execute, the orphaned props and extra indexes may still be reported
+func (p *MigrationPlan) Empty() bool {
+	return len(p.steps) == 0
+}
+
+// String returns the statements of the plan, one per line
+func (p *MigrationPlan) String() string {
+	var sb strings.Builder
+	for _, nGQL := range p.NGQL() {
+		sb.WriteString(nGQL)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func (p *MigrationPlan) addStep(diff *SchemaDiff, step planStep) error {
+	stmt := p.newStatement()
+	step(stmt)
+	nGQL, err := stmt.NGQL()
+	if err != nil {
+		return err
+	}
+	diff.NGQL = append(diff.NGQL, nGQL)
+	p.steps = append(p.steps, step)
+	return nil
+}
+
+// Apply executes the statements of the plan in order, it stops at the first failed statement
+func (m *Migrator) Apply(plan *MigrationPlan) error {
+	for _, step := range plan.steps {
+		tx := m.db.getInstance()
+		step(tx.Statement)
+		if err := tx.Exec(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PlanVertexes compares the tags of the given vertices with the ones in the current graph space, and returns the plan
+// that AutoMigrateVertexes would execute, nothing is changed in the graph space.
+//
+//	plan, err := db.Migrator().PlanVertexes(Player{})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Print(plan)
+//	// ALTER TAG player ADD (email string);
+//	// CREATE TAG INDEX IF NOT EXISTS idx_player_email ON player(email(32));
+func (m *Migrator) PlanVertexes(vertexes ...any) (*MigrationPlan, error) {
+	plan := m.newPlan()
+	for _, vertex := range vertexes {
+		vertexSchema, err := resolver.ParseVertex(reflect.TypeOf(vertex))
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range vertexSchema.GetTags() {
+			if err = m.planSchema(plan, newTagTarget(tag)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return plan, nil
+}
+
+// PlanEdges compares the given edges with the ones in the current graph space, and returns the plan that
+// AutoMigrateEdges would execute, nothing is changed in the graph space.
+func (m *Migrator) PlanEdges(edges ...any) (*MigrationPlan, error) {
+	plan := m.newPlan()
+	for _, edge := range edges {
+		edgeSchema, err := resolver.ParseEdge(reflect.TypeOf(edge))
+		if err != nil {
+			return nil, err
+		}
+		if err = m.planSchema(plan, newEdgeTarget(edgeSchema)); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// newPlan returns an empty plan whose statements are rendered in the same way as the DB of the Migrator builds them
+func (m *Migrator) newPlan() *MigrationPlan {
+	return &MigrationPlan{newStatement: m.db.newStatement}
+}
+
+// schemaTarget abstracts the tag or edge being planned, so that both are compared in the same way
+type schemaTarget struct {
+	kind        SchemaKind
+	name        string
+	comment     string
+	props       []*resolver.Prop
+	indexes     []*resolver.Index
+	create      func(stmt *statement.Statement)
+	alter       func(stmt *statement.Statement, op clause.AlterOperate)
+	createIndex func(stmt *statement.Statement, index *resolver.Index)
+	dropIndex   func(stmt *statement.Statement, indexName string)
+}
+
+func newTagTarget(tag *resolver.VertexTag) *schemaTarget {
+	return &schemaTarget{
+		kind:    SchemaKindTag,
+		name:    tag.TagName,
+		comment: tag.Comment,
+		props:   tag.GetProps(),
+		indexes: tag.GetIndexes(),
+		create: func(stmt *statement.Statement) {
+			stmt.CreateVertexTags(tag, true)
+		},
+		alter: func(stmt *statement.Statement, op clause.AlterOperate) {
+			stmt.AlterVertexTag(tag, op)
+		},
+		createIndex: func(stmt *statement.Statement, index *resolver.Index) {
+			stmt.CreateVertexTagsIndex(index, true)
+		},
+		dropIndex: func(stmt *statement.Statement, indexName string) {
+			stmt.DropVertexTagIndex(indexName, true)
+		},
+	}
+}
+
+func newEdgeTarget(edge *resolver.EdgeSchema) *schemaTarget {
+	return &schemaTarget{
+		kind:    SchemaKindEdge,
+		name:    edge.GetTypeName(),
+		comment: edge.GetComment(),
+		props:   edge.GetProps(),
+		indexes: edge.GetIndexes(),
+		create: func(stmt *statement.Statement) {
+			stmt.CreateEdge(edge, true)
+		},
+		alter: func(stmt *statement.Statement, op clause.AlterOperate) {
+			stmt.AlterEdge(edge, op)
+		},
+		createIndex: func(stmt *statement.Statement, index *resolver.Index) {
+			stmt.CreateEdgeIndex(index, true)
+		},
+		dropIndex: func(stmt *statement.Statement, indexName string) {
+			stmt.DropEdgeIndex(indexName, true)
+		},
+	}
+}
+
+// planSchema compares the tag or edge with the one in the graph space and adds the statements to the plan. The
+// statements are ordered so that each of them is accepted by nebula graph: the indexes are dropped before the props
+// they are built on are changed or dropped, and the ttl is changed before the ttl col is dropped.
+func (m *Migrator) planSchema(plan *MigrationPlan, target *schemaTarget) error {
+	diff := &SchemaDiff{Kind: target.kind, Name: target.name}
+	plan.Diffs = append(plan.Diffs, diff)
+	exists, err := m.hasSchema(target)
+	if err != nil {
+		return err
+	}
+	indexesExist, err := m.descIndexes(target.kind)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		diff.Created = true
+		for _, prop := range target.props {
+			diff.AddedProps = append(diff.AddedProps, prop.Name)
+		}
+		if err = plan.addStep(diff, target.create); err != nil {
+			return err
+		}
+	} else {
+		if err = m.planDropIndexes(plan, diff, target, indexesExist); err != nil {
+			return err
+		}
+		if err = m.planAlter(plan, diff, target); err != nil {
+			return err
+		}
+	}
+
+	indexNames := make(map[string]bool, len(indexesExist))
+	for _, index := range indexesExist {
+		indexNames[index.Name] = true
+	}
+	for _, index := range target.indexes {
+		if indexNames[index.Name] && !slices.Contains(diff.DroppedIndexes, index.Name) {
+			continue
+		}
+		if !indexNames[index.Name] {
+			diff.MissingIndexes = append(diff.MissingIndexes, index.Name)
+		}
+		err = plan.addStep(diff, func(stmt *statement.Statement) {
+			target.createIndex(stmt, index)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) hasSchema(target *schemaTarget) (bool, error) {
+	if target.kind == SchemaKindTag {
+		return m.HasVertexTag(target.name)
+	}
+	return m.HasEdge(target.name)
+}
+
+// planDropIndexes records the extra and changed indexes of the existing schema, and drops the ones allowed by the options
+func (m *Migrator) planDropIndexes(plan *MigrationPlan, diff *SchemaDiff, target *schemaTarget, indexesExist []*indexDesc) error {
+	declared := make(map[string]*resolver.Index, len(target.indexes))
+	for _, index := range target.indexes {
+		declared[index.Name] = index
+	}
+	for _, indexExist := range indexesExist {
+		if indexExist.Schema != target.name {
+			continue
+		}
+		var confirm DropConfirm
+		index, ok := declared[indexExist.Name]
+		switch {
+		case !ok:
+			diff.ExtraIndexes = append(diff.ExtraIndexes, indexExist.Name)
+			confirm = m.options.dropUnknownIndexes
+		case !slices.Equal(indexColumns(index), indexExist.Columns):
+			diff.ChangedIndexes = append(diff.ChangedIndexes, indexExist.Name)
+			confirm = m.options.recreateChangedIndexes
+		default:
+			continue
+		}
+		if !m.options.confirm(confirm, target.kind, target.name, indexExist.Name) {
+			continue
+		}
+		diff.DroppedIndexes = append(diff.DroppedIndexes, indexExist.Name)
+		indexName := indexExist.Name
+		err := plan.addStep(diff, func(stmt *statement.Statement) {
+			target.dropIndex(stmt, indexName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planAlter records the differences of the props and the ttl, and alters the existing schema accordingly, the props
+// are dropped by a separate statement after the others are altered
+func (m *Migrator) planAlter(plan *MigrationPlan, diff *SchemaDiff, target *schemaTarget) error {
+	var (
+		propsExist   []*PropDesc
+		createSchema []string
+		err          error
+	)
+	if target.kind == SchemaKindTag {
+		propsExist, err = m.DescVertexTag(target.name)
+		if err == nil {
+			err = m.db.Raw("SHOW CREATE TAG "+target.name).FindCol("Create Tag", &createSchema)
+		}
+	} else {
+		propsExist, err = m.DescEdge(target.name)
+		if err == nil {
+			err = m.db.Raw("SHOW CREATE EDGE "+target.name).FindCol("Create Edge", &createSchema)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	alterOp := diffProps(diff, propsExist, target.props, createSchema)
+	if len(createSchema) > 0 {
+		if comment := resolver.ParseSchemaComment(createSchema[0]); comment != target.comment {
+			diff.Comment = &CommentChange{Old: comment, New: target.comment}
+		}
+	}
+
+	dropOp := clause.AlterOperate{}
+	for _, prop := range diff.OrphanedProps {
+		if m.options.confirm(m.options.dropUnknownProps, target.kind, target.name, prop) {
+			dropOp.DropProps = append(dropOp.DropProps, prop)
+		}
+	}
+	diff.DroppedProps = dropOp.DropProps
+	// the ttl col cannot be dropped until the ttl is removed
+	if diff.TTL != nil && diff.TTL.NewCol == "" && slices.Contains(dropOp.DropProps, diff.TTL.OldCol) {
+		alterOp.DropTTL = true
+	}
+
+	if len(alterOp.AddProps) > 0 || len(alterOp.ChangeProps) > 0 || alterOp.DropTTL {
+		err = plan.addStep(diff, func(stmt *statement.Statement) {
+			target.alter(stmt, alterOp)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(dropOp.DropProps) > 0 {
+		return plan.addStep(diff, func(stmt *statement.Statement) {
+			target.alter(stmt, dropOp)
+		})
+	}
+	return nil
+}
+
+// indexDesc is the description of an index returned by SHOW TAG INDEXES or SHOW EDGE INDEXES
+type indexDesc struct {
+	Name    string
+	Schema  string
+	Columns []string
+}
+
+func (m *Migrator) descIndexes(kind SchemaKind) ([]*indexDesc, error) {
+	indexes := make([]*indexDesc, 0)
+	if kind == SchemaKindTag {
+		tagIndexes := make([]*tagIndexDesc, 0)
+		if err := m.db.Raw("SHOW TAG INDEXES").Find(&tagIndexes); err != nil {
+			return nil, err
+		}
+		for _, index := range tagIndexes {
+			indexes = append(indexes, &indexDesc{Name: index.Name, Schema: index.Tag, Columns: index.Columns})
+		}
+		return indexes, nil
+	}
+	edgeIndexes := make([]*edgeIndexDesc, 0)
+	if err := m.db.Raw("SHOW EDGE INDEXES").Find(&edgeIndexes); err != nil {
+		return nil, err
+	}
+	for _, index := range edgeIndexes {
+		indexes = append(indexes, &indexDesc{Name: index.Name, Schema: index.Edge, Columns: index.Columns})
+	}
+	return indexes, nil
+}
+
+type tagIndexDesc struct {
+	Name    string   `norm:"col:Index Name"`
+	Tag     string   `norm:"col:By Tag"`
+	Columns []string `norm:"col:Columns"`
+}
+
+type edgeIndexDesc struct {
+	Name    string   `norm:"col:Index Name"`
+	Edge    string   `norm:"col:By Edge"`
+	Columns []string `norm:"col:Columns"`
+}
+
+// indexColumns returns the props of the declared index in the order they are indexed
+func indexColumns(index *resolver.Index) []string {
+	fields := slices.Clone(index.Fields)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Priority < fields[j].Priority
+	})
+	columns := make([]string, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, field.Prop)
+	}
+	return columns
+}
+
+// diffProps records the differences between the existing props and the declared props into the diff, and returns the
+// alter operation that adds the props and changes their types, null constraints and default values, the changes of
+// the comments and the ttl are only recorded
+func diffProps(diff *SchemaDiff, propsExist []*PropDesc, propsNew []*resolver.Prop, createSchema []string) clause.AlterOperate {
+	propExistByName := make(map[string]*PropDesc, len(propsExist))
+	for _, propExist := range propsExist {
+		propExistByName[propExist.Field] = propExist
+	}
+	declared := make(map[string]bool, len(propsNew))
+	alterOp := clause.AlterOperate{}
+	newTTL := TTLChange{}
+	for _, propNew := range propsNew {
+		declared[propNew.Name] = true
+		if propNew.TTL != "" {
+			newTTL.NewCol, newTTL.NewDuration = propNew.Name, propNew.TTL
+		}
+		// Add the property if it does not exist
+		propExist, ok := propExistByName[propNew.Name]
+		if !ok {
+			diff.AddedProps = append(diff.AddedProps, propNew.Name)
+			alterOp.AddProps = append(alterOp.AddProps, propNew.Name)
+			continue
+		}
+		// Apply change if the property differs from existing definition
+		if change := diffProp(propExist, propNew); change != nil {
+			diff.ChangedProps = append(diff.ChangedProps, change)
+			if change.altered() {
+				alterOp.ChangeProps = append(alterOp.ChangeProps, propNew.Name)
+			}
+		}
+	}
+	for _, propExist := range propsExist {
+		if !declared[propExist.Field] {
+			diff.OrphanedProps = append(diff.OrphanedProps, propExist.Field)
+		}
+	}
+
+	if len(createSchema) > 0 {
+		newTTL.OldCol, newTTL.OldDuration = resolver.ParseSchemaTTL(createSchema[0])
+	}
+	if newTTL.OldCol != newTTL.NewCol || (newTTL.NewCol != "" && newTTL.OldDuration != newTTL.NewDuration) {
+		diff.TTL = &newTTL
+	}
+	return alterOp
+}
+
+// diffProp determines whether a property definition has changed by comparing type, nullability, default value and
+// comment, nil is returned if nothing is changed
+func diffProp(propExist *PropDesc, propNew *resolver.Prop) *PropChange {
+	propType := func(t string) string {
+		t = strings.ToLower(t)
+		// "int" is treated as an alias for "int64"
+		if t == "int" {
+			t = "int64"
+		}
+		return t
+	}
+
+	notNull := func(s string) bool {
+		if strings.ToLower(s) == "yes" {
+			return false
+		}
+		return true
+	}
+
+	// the defaults are compared as they are written in nGQL, so that "none" and none of a string prop are the same
+	defaultValue := func(s string) string {
+		if s == "_EMPTY_" {
+			return ""
+		}
+		if s == "" {
+			s = "''"
+		}
+		return (&resolver.Prop{DataType: propExist.Type, Default: s}).DefaultNGQL()
+	}
+
+	comment := func(s string) string {
+		if s == "_EMPTY_" {
+			return ""
+		}
+		return s
+	}
+
+	change := &PropChange{
+		Name:           propNew.Name,
+		Old:            propExist,
+		New:            propNew,
+		TypeChanged:    propType(propNew.DataType) != propType(propExist.Type),
+		NullChanged:    propNew.NotNull != notNull(propExist.Null),
+		DefaultChanged: propNew.DefaultNGQL() != defaultValue(propExist.Default),
+		CommentChanged: propNew.Comment != comment(propExist.Comment),
+	}
+	if !change.TypeChanged && !change.NullChanged && !change.DefaultChanged && !change.CommentChanged {
+		return nil
+	}
+	return change
+}
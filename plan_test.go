@@ -0,0 +1,286 @@
+package norm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+	"github.com/haysons/norm/resolver"
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+func TestDiffProp(t *testing.T) {
+	tests := []struct {
+		exist *PropDesc
+		new   *resolver.Prop
+		want  *PropChange
+	}{
+		{
+			exist: &PropDesc{Field: "age", Type: "int64", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "age", DataType: "int"},
+		},
+		{
+			exist: &PropDesc{Field: "name", Type: "string", Null: "NO", Default: "\"none\"", Comment: "name of player"},
+			new:   &resolver.Prop{Name: "name", DataType: "string", NotNull: true, Default: "\"none\"", Comment: "name of player"},
+		},
+		{
+			exist: &PropDesc{Field: "name", Type: "string", Null: "NO", Default: "", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "name", DataType: "string", NotNull: true, Default: "''"},
+		},
+		{
+			exist: &PropDesc{Field: "name", Type: "string", Null: "YES", Default: "\"none\"", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "name", DataType: "string", Default: "none"},
+		},
+		{
+			exist: &PropDesc{Field: "name", Type: "fixed_string(16)", Null: "YES", Default: "\"none\"", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "name", DataType: "fixed_string(16)", Default: "none"},
+		},
+		{
+			exist: &PropDesc{Field: "name", Type: "fixed_string(16)", Null: "YES", Default: "", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "name", DataType: "fixed_string(16)", Default: "''"},
+		},
+		{
+			exist: &PropDesc{Field: "name", Type: "fixed_string(16)", Null: "YES", Default: "\"none\"", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "name", DataType: "fixed_string(16)", Default: "unknown"},
+			want:  &PropChange{Name: "name", DefaultChanged: true},
+		},
+		{
+			exist: &PropDesc{Field: "age", Type: "int32", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "age", DataType: "int64"},
+			want:  &PropChange{Name: "age", TypeChanged: true},
+		},
+		{
+			exist: &PropDesc{Field: "age", Type: "int64", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+			new:   &resolver.Prop{Name: "age", DataType: "int64", NotNull: true, Default: "0", Comment: "age"},
+			want:  &PropChange{Name: "age", NullChanged: true, DefaultChanged: true, CommentChanged: true},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got := diffProp(tt.exist, tt.new)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				tt.want.Old, tt.want.New = tt.exist, tt.new
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffProps(t *testing.T) {
+	propsExist := []*PropDesc{
+		{Field: "name", Type: "string", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+		{Field: "age", Type: "int32", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+		{Field: "created_at", Type: "timestamp", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+		{Field: "level", Type: "int64", Null: "YES", Default: "_EMPTY_", Comment: "_EMPTY_"},
+	}
+	ttlSchema := "CREATE TAG `player` (\n `name` string NULL\n) ttl_duration = 100, ttl_col = \"created_at\", comment = \"\""
+	noTTLSchema := "CREATE TAG `player` (\n `name` string NULL\n) ttl_duration = 0, ttl_col = \"\", comment = \"\""
+	tests := []struct {
+		propsNew     []*resolver.Prop
+		createSchema []string
+		added        []string
+		changed      []string
+		orphaned     []string
+		ttl          *TTLChange
+		alterOp      clause.AlterOperate
+	}{
+		{
+			propsNew: []*resolver.Prop{
+				{Name: "name", DataType: "string"},
+				{Name: "age", DataType: "int64"},
+				{Name: "created_at", DataType: "timestamp", TTL: "100"},
+				{Name: "level", DataType: "int64"},
+			},
+			createSchema: []string{ttlSchema},
+			changed:      []string{"age"},
+			alterOp:      clause.AlterOperate{ChangeProps: []string{"age"}},
+		},
+		{
+			propsNew: []*resolver.Prop{
+				{Name: "name", DataType: "string"},
+				{Name: "age", DataType: "int32"},
+				{Name: "created_at", DataType: "timestamp", TTL: "200"},
+				{Name: "gender", DataType: "string"},
+			},
+			createSchema: []string{ttlSchema},
+			added:        []string{"gender"},
+			orphaned:     []string{"level"},
+			ttl:          &TTLChange{OldCol: "created_at", OldDuration: "100", NewCol: "created_at", NewDuration: "200"},
+			alterOp:      clause.AlterOperate{AddProps: []string{"gender"}},
+		},
+		{
+			propsNew: []*resolver.Prop{
+				{Name: "name", DataType: "string"},
+				{Name: "age", DataType: "int32"},
+				{Name: "created_at", DataType: "timestamp"},
+				{Name: "level", DataType: "int64"},
+			},
+			createSchema: []string{ttlSchema},
+			ttl:          &TTLChange{OldCol: "created_at", OldDuration: "100"},
+		},
+		{
+			propsNew: []*resolver.Prop{
+				{Name: "name", DataType: "string"},
+				{Name: "age", DataType: "int32"},
+				{Name: "created_at", DataType: "timestamp", TTL: "100"},
+				{Name: "level", DataType: "int64"},
+			},
+			createSchema: []string{noTTLSchema},
+			ttl:          &TTLChange{NewCol: "created_at", NewDuration: "100"},
+		},
+		{
+			propsNew: []*resolver.Prop{
+				{Name: "name", DataType: "string", Comment: "name of player"},
+				{Name: "age", DataType: "int32"},
+				{Name: "created_at", DataType: "timestamp", TTL: "100"},
+				{Name: "level", DataType: "int64"},
+			},
+			createSchema: []string{ttlSchema},
+			changed:      []string{"name"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			diff := &SchemaDiff{}
+			alterOp := diffProps(diff, propsExist, tt.propsNew, tt.createSchema)
+			assert.Equal(t, tt.alterOp, alterOp)
+			assert.Equal(t, tt.added, diff.AddedProps)
+			assert.Equal(t, tt.orphaned, diff.OrphanedProps)
+			assert.Equal(t, tt.ttl, diff.TTL)
+			var changed []string
+			for _, change := range diff.ChangedProps {
+				changed = append(changed, change.Name)
+			}
+			assert.Equal(t, tt.changed, changed)
+		})
+	}
+}
+
+func TestIndexColumns(t *testing.T) {
+	tests := []struct {
+		index *resolver.Index
+		want  []string
+	}{
+		{index: &resolver.Index{Name: "idx_player"}, want: []string{}},
+		{index: &resolver.Index{Name: "idx_player_name", Fields: []*resolver.IndexField{{Prop: "name", Priority: 10}}}, want: []string{"name"}},
+		{
+			index: &resolver.Index{Name: "idx_player_name_age", Fields: []*resolver.IndexField{
+				{Prop: "name", Priority: 10}, {Prop: "age", Priority: 5}, {Prop: "level", Priority: 10},
+			}},
+			want: []string{"age", "name", "level"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, indexColumns(tt.index))
+		})
+	}
+	index := &resolver.Index{Fields: []*resolver.IndexField{{Prop: "name", Priority: 10}, {Prop: "age", Priority: 5}}}
+	indexColumns(index)
+	assert.Equal(t, "name", index.Fields[0].Prop)
+}
+
+type planPlayer struct {
+	VID       string `norm:"vertex_id"`
+	Name      string `norm:"prop:name;comment:name of player"`
+	Age       int64  `norm:"prop:age"`
+	CreatedAt int64  `norm:"prop:created_at;type:timestamp;ttl:200"`
+}
+
+func (p planPlayer) VertexID() string {
+	return p.VID
+}
+
+func (p planPlayer) VertexTagName() string {
+	return "player"
+}
+
+func (p planPlayer) VertexTagComment() string {
+	return "the players"
+}
+
+func TestPlanVertexes(t *testing.T) {
+	tests := []struct {
+		propsExist   [][]any
+		createSchema string
+		diff         *SchemaDiff
+		nGQL         []string
+	}{
+		{
+			propsExist: [][]any{
+				{"name", "string", "YES", "_EMPTY_", "_EMPTY_"},
+				{"age", "int64", "YES", "_EMPTY_", "_EMPTY_"},
+				{"created_at", "timestamp", "YES", "_EMPTY_", "_EMPTY_"},
+			},
+			createSchema: "CREATE TAG `player` (\n `name` string NULL\n) ttl_duration = 100, ttl_col = \"created_at\", comment = \"\"",
+			diff: &SchemaDiff{
+				TTL:     &TTLChange{OldCol: "created_at", OldDuration: "100", NewCol: "created_at", NewDuration: "200"},
+				Comment: &CommentChange{New: "the players"},
+			},
+			nGQL: []string{},
+		},
+		{
+			propsExist: [][]any{
+				{"name", "string", "YES", "_EMPTY_", "name of player"},
+				{"age", "int32", "YES", "_EMPTY_", "_EMPTY_"},
+			},
+			createSchema: "CREATE TAG `player` (\n `name` string NULL\n) ttl_duration = 0, ttl_col = \"\", comment = \"the players\"",
+			diff: &SchemaDiff{
+				AddedProps: []string{"created_at"},
+				TTL:        &TTLChange{NewCol: "created_at", NewDuration: "200"},
+			},
+			nGQL: []string{"ALTER TAG player ADD (created_at timestamp), CHANGE (age int64);"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+				switch nGQL {
+				case "SHOW TAGS":
+					return genDataSet(t, []string{"Name"}, []any{"player"})
+				case "SHOW TAG INDEXES":
+					return genDataSet(t, []string{"Index Name", "By Tag", "Columns"})
+				case "DESCRIBE TAG player":
+					return genDataSet(t, []string{"Field", "Type", "Null", "Default", "Comment"}, tt.propsExist...)
+				case "SHOW CREATE TAG player":
+					return genDataSet(t, []string{"Tag", "Create Tag"}, []any{"player", tt.createSchema})
+				}
+				t.Fatalf("unexpected statement %s", nGQL)
+				return nil
+			})
+			plan, err := db.Migrator().PlanVertexes(planPlayer{})
+			if !assert.NoError(t, err) || !assert.Len(t, plan.Diffs, 1) {
+				return
+			}
+			diff := plan.Diffs[0]
+			assert.Equal(t, tt.diff.AddedProps, diff.AddedProps)
+			assert.Equal(t, tt.diff.TTL, diff.TTL)
+			assert.Equal(t, tt.diff.Comment, diff.Comment)
+			assert.True(t, diff.Changed())
+			assert.Equal(t, tt.nGQL, plan.NGQL())
+		})
+	}
+}
+
+func TestPlanStatement(t *testing.T) {
+	db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+		switch nGQL {
+		case "SHOW TAGS", "SHOW TAG INDEXES":
+			return genDataSet(t, []string{"Name"})
+		}
+		t.Fatalf("unexpected statement %s", nGQL)
+		return nil
+	})
+	db.conf.parameterized = true
+	plan, err := db.Migrator().PlanVertexes(planPlayer{})
+	if assert.NoError(t, err) {
+		assert.True(t, plan.newStatement().IsParameterized())
+		assert.Equal(t, "CREATE TAG IF NOT EXISTS player(name string COMMENT \"name of player\", age int64, created_at timestamp) TTL_DURATION = 200, TTL_COL = \"created_at\", COMMENT = \"the players\";\n", plan.String())
+		assert.Equal(t, []string{`CREATE TAG IF NOT EXISTS player(name string COMMENT "name of player", age int64, created_at timestamp) TTL_DURATION = 200, TTL_COL = "created_at", COMMENT = "the players";`}, plan.NGQL())
+	}
+}
@@ -0,0 +1,65 @@
+package resolver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+// Duration is the duration data type of nebula graph. Months are kept apart from seconds since the length of a month
+// varies, Microseconds is the fraction of the second.
+type Duration struct {
+	Months       int32
+	Seconds      int64
+	Microseconds int32
+}
+
+// NewDuration converts time.Duration into Duration, the precision is microsecond
+func NewDuration(d time.Duration) Duration {
+	return Duration{
+		Seconds:      int64(d / time.Second),
+		Microseconds: int32(d % time.Second / time.Microsecond),
+	}
+}
+
+// TimeDuration converts the duration into time.Duration, a month is treated as 30 days
+func (d Duration) TimeDuration() time.Duration {
+	return time.Duration(d.Months)*30*24*time.Hour + time.Duration(d.Seconds)*time.Second +
+		time.Duration(d.Microseconds)*time.Microsecond
+}
+
+// NGQLValue formats the duration into a duration() literal, such as duration({months: 1, seconds: 90})
+func (d Duration) NGQLValue() (string, error) {
+	fields := make([]string, 0, 3)
+	if d.Months != 0 {
+		fields = append(fields, "months: "+strconv.FormatInt(int64(d.Months), 10))
+	}
+	if d.Seconds != 0 || (d.Months == 0 && d.Microseconds == 0) {
+		fields = append(fields, "seconds: "+strconv.FormatInt(d.Seconds, 10))
+	}
+	if d.Microseconds != 0 {
+		fields = append(fields, "microseconds: "+strconv.FormatInt(int64(d.Microseconds), 10))
+	}
+	return "duration({" + strings.Join(fields, ", ") + "})", nil
+}
+
+// ScanNebulaValue scans a duration value into the duration
+func (d *Duration) ScanNebulaValue(value *nebula.ValueWrapper) error {
+	if value.IsNull() {
+		*d = Duration{}
+		return nil
+	}
+	duration, err := value.AsDuration()
+	if err != nil {
+		return fmt.Errorf("norm: %w", err)
+	}
+	*d = Duration{
+		Months:       duration.GetMonths(),
+		Seconds:      duration.GetSeconds(),
+		Microseconds: duration.GetMicroseconds(),
+	}
+	return nil
+}
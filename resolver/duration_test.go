@@ -0,0 +1,36 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{value: Duration{}, want: `duration({seconds: 0})`},
+		{value: Duration{Seconds: 90}, want: `duration({seconds: 90})`},
+		{value: Duration{Months: 14, Seconds: 3600, Microseconds: 500}, want: `duration({months: 14, seconds: 3600, microseconds: 500})`},
+		{value: &Duration{Months: 1}, want: `duration({months: 1})`},
+		{value: NewDuration(90*time.Second + 1500*time.Microsecond), want: `duration({seconds: 90, microseconds: 1500})`},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, err := FormatSimpleValue("", reflect.ValueOf(tt.value))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_TimeDuration(t *testing.T) {
+	assert.Equal(t, 90*time.Second+1500*time.Microsecond, NewDuration(90*time.Second+1500*time.Microsecond).TimeDuration())
+	assert.Equal(t, 30*24*time.Hour+time.Second, Duration{Months: 1, Seconds: 1}.TimeDuration())
+}
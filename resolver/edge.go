@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/haysons/norm/internal/utils"
 	nebula "github.com/vesoft-inc/nebula-go/v3"
 )
 
@@ -14,12 +15,18 @@ type EdgeTypeNamer interface {
 	EdgeTypeName() string
 }
 
+// EdgeTypeCommenter the edge structure that implements this interface has a comment, which is set when the edge type is created
+type EdgeTypeCommenter interface {
+	EdgeTypeComment() string
+}
+
 type EdgeSchema struct {
 	srcVIDType       VIDType
 	srcVIDFieldIndex []int
 	dstVIDType       VIDType
 	dstVIDFieldIndex []int
 	edgeTypeName     string
+	comment          string
 	rankFieldIndex   []int
 	props            []*Prop
 	propByName       map[string]*Prop
@@ -49,6 +56,9 @@ func ParseEdge(destType reflect.Type) (*EdgeSchema, error) {
 		return nil, errors.New("norm: parse edge failed, need to implement interface resolver.EdgeTypeNamer")
 	}
 	edge.edgeTypeName = edgeTypeNamer.EdgeTypeName()
+	if edgeTypeCommenter, ok := destValue.(EdgeTypeCommenter); ok {
+		edge.comment = edgeTypeCommenter.EdgeTypeComment()
+	}
 	for _, field := range getDestFields(destType) {
 		setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
 		if _, isSrcID := setting[TagSettingEdgeSrcID]; isSrcID {
@@ -96,17 +106,23 @@ func ParseEdge(destType reflect.Type) (*EdgeSchema, error) {
 		propDefault := GetFieldDefault(field)
 		comment := GetFieldComment(field)
 		ttl := GetFieldTTL(field)
+		softDelete := IsFieldSoftDelete(field)
+		autoCreateTime := GetFieldAutoTime(field, TagSettingAutoCreateTime)
+		autoUpdateTime := GetFieldAutoTime(field, TagSettingAutoUpdateTime)
 		index := GetFieldIndex(field, edge.edgeTypeName, propName, dataType)
 		prop := &Prop{
-			Name:        propName,
-			StructField: field,
-			Type:        field.Type,
-			SdkType:     sdkType,
-			DataType:    dataType,
-			NotNull:     notNull,
-			Default:     propDefault,
-			Comment:     comment,
-			TTL:         ttl,
+			Name:           propName,
+			StructField:    field,
+			Type:           field.Type,
+			SdkType:        sdkType,
+			DataType:       dataType,
+			NotNull:        notNull,
+			Default:        propDefault,
+			Comment:        comment,
+			TTL:            ttl,
+			SoftDelete:     softDelete,
+			AutoCreateTime: autoCreateTime,
+			AutoUpdateTime: autoUpdateTime,
 		}
 		if _, ok = edge.propByName[propName]; ok {
 			continue
@@ -130,11 +146,21 @@ func (e *EdgeSchema) SetTypeName(edgeTypeName string) {
 	e.edgeTypeName = edgeTypeName
 }
 
+// GetComment get the comment of the edge type
+func (e *EdgeSchema) GetComment() string {
+	return e.comment
+}
+
+// SetComment set the comment of the edge type
+func (e *EdgeSchema) SetComment(comment string) {
+	e.comment = comment
+}
+
 // GetSrcVID get the src_id of the edge
 func (e *EdgeSchema) GetSrcVID(edgeValue reflect.Value) any {
 	if e.srcVIDFieldIndex != nil {
 		edgeValue = reflect.Indirect(edgeValue)
-		return edgeValue.FieldByIndex(e.srcVIDFieldIndex).Interface()
+		return utils.FieldByIndexOrZero(edgeValue, e.srcVIDFieldIndex).Interface()
 	}
 	return nil
 }
@@ -158,7 +184,7 @@ func (e *EdgeSchema) GetSrcVIDExpr(edgeValue reflect.Value) string {
 func (e *EdgeSchema) GetDstVID(edgeValue reflect.Value) any {
 	if e.dstVIDFieldIndex != nil {
 		edgeValue = reflect.Indirect(edgeValue)
-		return edgeValue.FieldByIndex(e.dstVIDFieldIndex).Interface()
+		return utils.FieldByIndexOrZero(edgeValue, e.dstVIDFieldIndex).Interface()
 	}
 	return nil
 }
@@ -182,7 +208,7 @@ func (e *EdgeSchema) GetDstVIDExpr(edgeValue reflect.Value) string {
 func (e *EdgeSchema) GetRank(edgeValue reflect.Value) int64 {
 	if e.rankFieldIndex != nil {
 		edgeValue = reflect.Indirect(edgeValue)
-		return edgeValue.FieldByIndex(e.rankFieldIndex).Int()
+		return utils.FieldByIndexOrZero(edgeValue, e.rankFieldIndex).Int()
 	}
 	return 0
 }
@@ -192,6 +218,16 @@ func (e *EdgeSchema) GetProps() []*Prop {
 	return e.props
 }
 
+// GetSoftDeleteProp get the prop marking the soft deletion of the edge, nil is returned if there is none
+func (e *EdgeSchema) GetSoftDeleteProp() *Prop {
+	for _, prop := range e.props {
+		if prop.SoftDelete {
+			return prop
+		}
+	}
+	return nil
+}
+
 // SetProps set attributes of the edge
 func (e *EdgeSchema) SetProps(props ...*Prop) {
 	if e.propByName == nil {
@@ -240,26 +276,29 @@ func (e *EdgeSchema) Scan(rl *nebula.Relationship, destValue reflect.Value) erro
 	}
 	if e.srcVIDFieldIndex != nil {
 		srcID := rl.GetSrcVertexID()
-		if err := ScanSimpleValue(&srcID, destValue.FieldByIndex(e.srcVIDFieldIndex)); err != nil {
+		if err := ScanSimpleValue(&srcID, utils.FieldByIndexAlloc(destValue, e.srcVIDFieldIndex)); err != nil {
 			return err
 		}
 	}
 	if e.dstVIDFieldIndex != nil {
 		dstID := rl.GetDstVertexID()
-		if err := ScanSimpleValue(&dstID, destValue.FieldByIndex(e.dstVIDFieldIndex)); err != nil {
+		if err := ScanSimpleValue(&dstID, utils.FieldByIndexAlloc(destValue, e.dstVIDFieldIndex)); err != nil {
 			return err
 		}
 	}
 	if e.rankFieldIndex != nil {
-		rank := rl.GetRanking()
-		destValue.FieldByIndex(e.rankFieldIndex).SetInt(rank)
+		rankValue := utils.FieldByIndexAlloc(destValue, e.rankFieldIndex)
+		if !rankValue.CanSet() {
+			return fmt.Errorf("norm: edge schema scan rank failed, %w", ErrValueCannotSet)
+		}
+		rankValue.SetInt(rl.GetRanking())
 	}
 	for propName, propValue := range rl.Properties() {
 		eProp, ok := e.propByName[propName]
 		if !ok {
 			continue
 		}
-		if err := ScanSimpleValue(propValue, destValue.FieldByIndex(eProp.StructField.Index)); err != nil {
+		if err := ScanSimpleValue(propValue, utils.FieldByIndexAlloc(destValue, eProp.StructField.Index)); err != nil {
 			return err
 		}
 	}
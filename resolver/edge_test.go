@@ -31,6 +31,10 @@ func TestParseEdge(t *testing.T) {
 		{dest: &edge6{}, want: &EdgeSchema{srcVIDType: VIDTypeInt64, srcVIDFieldIndex: []int{0, 0}, dstVIDType: VIDTypeString, dstVIDFieldIndex: []int{1}, rankFieldIndex: nil, edgeTypeName: "edge6"}, wantProp: []prop{
 			{name: "name", index: []int{2}, nebulaType: "string"}, {name: "age", index: []int{3}, nebulaType: "int"},
 		}},
+		{dest: edge7{}, want: &EdgeSchema{srcVIDType: VIDTypeString, srcVIDFieldIndex: []int{0}, dstVIDType: VIDTypeString, dstVIDFieldIndex: []int{1}, rankFieldIndex: nil, edgeTypeName: "edge7"}, wantProp: []prop{
+			{name: "degree", index: []int{4}, nebulaType: "int"}, {name: "created_at", index: []int{2, 0}, nebulaType: "datetime"}, {name: "version", index: []int{2, 1}, nebulaType: "int"},
+			{name: "audit_created_at", index: []int{3, 0}, nebulaType: "datetime"}, {name: "audit_version", index: []int{3, 1}, nebulaType: "int"},
+		}},
 		{dest: edge3{}, wantErr: true},
 		{dest: record1{}, wantErr: true},
 	}
@@ -223,3 +227,15 @@ type edge6 struct {
 func (e *edge6) EdgeTypeName() string {
 	return "edge6"
 }
+
+type edge7 struct {
+	SrcID string `norm:"edge_src_id"`
+	DstID string `norm:"edge_dst_id"`
+	*baseModel
+	Audit  baseModel `norm:"embedded;prefix:audit_"`
+	Degree int
+}
+
+func (e edge7) EdgeTypeName() string {
+	return "edge7"
+}
@@ -0,0 +1,91 @@
+package resolver
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StructFields returns the exported fields of the struct type that are not ignored. The fields of anonymous embedded
+// structs, whether values or pointers, and of the struct fields tagged with embedded are promoted into the result, with
+// the index of each promoted field being the full path from the outermost struct. If the embedded field is tagged with
+// a prefix, it is added to the prop names and col names of the promoted fields, and the prefixes of nested embedded
+// structs are accumulated.
+//
+//	type BaseModel struct {
+//		CreatedAt time.Time
+//		Version   int64
+//	}
+//
+//	type Player struct {
+//		BaseModel                            // created_at, version
+//		Audit     BaseModel `norm:"embedded;prefix:audit_"` // audit_created_at, audit_version
+//	}
+func StructFields(t reflect.Type) []reflect.StructField {
+	return structFields(t, nil, "")
+}
+
+func structFields(t reflect.Type, parentIndex []int, prefix string) []reflect.StructField {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := make([]reflect.StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		// copy the parent index, so that the sibling fields do not share the same underlying array
+		index := make([]int, 0, len(parentIndex)+1)
+		index = append(index, parentIndex...)
+		field.Index = append(index, i)
+		if FieldIgnore(field) {
+			continue
+		}
+		if embedType, ok := embeddedStruct(field); ok {
+			setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
+			fields = append(fields, structFields(embedType, field.Index, prefix+setting[TagSettingPrefix])...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if prefix != "" {
+			field.Tag = prefixedTag(field, prefix)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// embeddedStruct reports whether the fields of the struct field should be promoted, and returns the struct type
+func embeddedStruct(field reflect.StructField) (reflect.Type, bool) {
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	// the types that are scanned and formatted as a whole are never promoted
+	if fieldType.Kind() != reflect.Struct || fieldType == reflect.TypeOf(time.Time{}) ||
+		isCustomScan(fieldType) || IsCustomFormat(fieldType) {
+		return nil, false
+	}
+	if field.Anonymous {
+		return fieldType, true
+	}
+	if !field.IsExported() {
+		return nil, false
+	}
+	_, ok := ParseTagSetting(field.Tag.Get(TagSettingKey))[TagSettingEmbedded]
+	return fieldType, ok
+}
+
+// prefixedTag returns the tag of the field with the prefixed prop name and col name, since the later settings override
+// the earlier ones, they are simply appended to the norm setting
+func prefixedTag(field reflect.StructField, prefix string) reflect.StructTag {
+	setting, ok := field.Tag.Lookup(TagSettingKey)
+	prefixed := setting + ";" + TagSettingPropName + ":" + prefix + GetPropName(field) +
+		";" + TagSettingColName + ":" + prefix + getColName(field)
+	if !ok {
+		return reflect.StructTag(strings.TrimSpace(string(field.Tag) + " " + TagSettingKey + ":" + strconv.Quote(prefixed)))
+	}
+	tag := strings.Replace(string(field.Tag), TagSettingKey+":"+strconv.Quote(setting), TagSettingKey+":"+strconv.Quote(prefixed), 1)
+	return reflect.StructTag(tag)
+}
@@ -0,0 +1,195 @@
+package resolver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
+)
+
+// Point is the geography(point) data type of nebula graph, X is the longitude and Y is the latitude
+//
+//	type Shop struct {
+//		Location resolver.Point // location geography(point)
+//	}
+type Point struct {
+	X float64
+	Y float64
+}
+
+// LineString is the geography(linestring) data type of nebula graph, it consists of at least two points
+type LineString []Point
+
+// Polygon is the geography(polygon) data type of nebula graph, it consists of closed rings, the first ring is the
+// outer boundary and the others are holes. Each ring has at least four points, and its first and last points are the same.
+type Polygon [][]Point
+
+// WKT returns the well-known text representation of the point, such as POINT(3 8)
+func (p Point) WKT() string {
+	return "POINT(" + p.coord() + ")"
+}
+
+// NGQLValue formats the point into an ST_GeogFromText literal
+func (p Point) NGQLValue() (string, error) {
+	return geogFromText(p.WKT()), nil
+}
+
+// ScanNebulaValue scans a geography(point) value into the point
+func (p *Point) ScanNebulaValue(value *nebula.ValueWrapper) error {
+	if value.IsNull() {
+		*p = Point{}
+		return nil
+	}
+	geo, err := asGeography(value)
+	if err != nil {
+		return err
+	}
+	if geo.PtVal == nil {
+		return fmt.Errorf("norm: can not scan geography %s into resolver.Point", geographyShape(geo))
+	}
+	*p = newPoint(geo.PtVal.GetCoord())
+	return nil
+}
+
+func (p Point) coord() string {
+	return strconv.FormatFloat(p.X, 'g', -1, 64) + " " + strconv.FormatFloat(p.Y, 'g', -1, 64)
+}
+
+// WKT returns the well-known text representation of the line string, such as LINESTRING(3 8, 4.7 73.23)
+func (l LineString) WKT() string {
+	return "LINESTRING(" + pointsWKT(l) + ")"
+}
+
+// NGQLValue formats the line string into an ST_GeogFromText literal, nil is formatted as NULL
+func (l LineString) NGQLValue() (string, error) {
+	if l == nil {
+		return "NULL", nil
+	}
+	return geogFromText(l.WKT()), nil
+}
+
+// ScanNebulaValue scans a geography(linestring) value into the line string
+func (l *LineString) ScanNebulaValue(value *nebula.ValueWrapper) error {
+	if value.IsNull() {
+		*l = nil
+		return nil
+	}
+	geo, err := asGeography(value)
+	if err != nil {
+		return err
+	}
+	if geo.LsVal == nil {
+		return fmt.Errorf("norm: can not scan geography %s into resolver.LineString", geographyShape(geo))
+	}
+	*l = newPoints(geo.LsVal.GetCoordList())
+	return nil
+}
+
+// WKT returns the well-known text representation of the polygon, such as POLYGON((0 1, 1 2, 2 3, 0 1))
+func (p Polygon) WKT() string {
+	rings := make([]string, 0, len(p))
+	for _, ring := range p {
+		rings = append(rings, "("+pointsWKT(ring)+")")
+	}
+	return "POLYGON(" + strings.Join(rings, ", ") + ")"
+}
+
+// NGQLValue formats the polygon into an ST_GeogFromText literal, nil is formatted as NULL
+func (p Polygon) NGQLValue() (string, error) {
+	if p == nil {
+		return "NULL", nil
+	}
+	return geogFromText(p.WKT()), nil
+}
+
+// ScanNebulaValue scans a geography(polygon) value into the polygon
+func (p *Polygon) ScanNebulaValue(value *nebula.ValueWrapper) error {
+	if value.IsNull() {
+		*p = nil
+		return nil
+	}
+	geo, err := asGeography(value)
+	if err != nil {
+		return err
+	}
+	if geo.PgVal == nil {
+		return fmt.Errorf("norm: can not scan geography %s into resolver.Polygon", geographyShape(geo))
+	}
+	*p = newPolygon(geo.PgVal)
+	return nil
+}
+
+func geogFromText(wkt string) string {
+	return `ST_GeogFromText("` + wkt + `")`
+}
+
+func pointsWKT(points []Point) string {
+	coords := make([]string, 0, len(points))
+	for _, point := range points {
+		coords = append(coords, point.coord())
+	}
+	return strings.Join(coords, ", ")
+}
+
+func asGeography(value *nebula.ValueWrapper) (*nebulattypes.Geography, error) {
+	geo, err := value.AsGeography()
+	if err != nil {
+		return nil, fmt.Errorf("norm: %w", err)
+	}
+	return geo, nil
+}
+
+func geographyShape(geo *nebulattypes.Geography) string {
+	switch {
+	case geo.PtVal != nil:
+		return "point"
+	case geo.LsVal != nil:
+		return "linestring"
+	case geo.PgVal != nil:
+		return "polygon"
+	}
+	return "unknown"
+}
+
+// geographyValue converts the geography returned by nebula graph into Point, LineString or Polygon
+func geographyValue(geo *nebulattypes.Geography) any {
+	switch {
+	case geo.PtVal != nil:
+		return newPoint(geo.PtVal.GetCoord())
+	case geo.LsVal != nil:
+		return LineString(newPoints(geo.LsVal.GetCoordList()))
+	case geo.PgVal != nil:
+		return newPolygon(geo.PgVal)
+	}
+	return nil
+}
+
+// geographyWKT returns the well-known text representation of the geography returned by nebula graph
+func geographyWKT(geo *nebulattypes.Geography) string {
+	if wkt, ok := geographyValue(geo).(interface{ WKT() string }); ok {
+		return wkt.WKT()
+	}
+	return ""
+}
+
+func newPoint(coord *nebulattypes.Coordinate) Point {
+	return Point{X: coord.GetX(), Y: coord.GetY()}
+}
+
+func newPoints(coords []*nebulattypes.Coordinate) []Point {
+	points := make([]Point, 0, len(coords))
+	for _, coord := range coords {
+		points = append(points, newPoint(coord))
+	}
+	return points
+}
+
+func newPolygon(pg *nebulattypes.Polygon) Polygon {
+	polygon := make(Polygon, 0, len(pg.GetCoordListList()))
+	for _, ring := range pg.GetCoordListList() {
+		polygon = append(polygon, newPoints(ring))
+	}
+	return polygon
+}
@@ -0,0 +1,99 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
+)
+
+func TestFormatGeography(t *testing.T) {
+	tests := []struct {
+		nebulaType string
+		value      any
+		want       string
+	}{
+		{value: Point{X: 3, Y: 8}, want: `ST_GeogFromText("POINT(3 8)")`},
+		{value: &Point{X: 120.5, Y: -30.25}, want: `ST_GeogFromText("POINT(120.5 -30.25)")`},
+		{value: (*Point)(nil), want: `NULL`},
+		{value: LineString{{X: 3, Y: 8}, {X: 4.7, Y: 73.23}}, want: `ST_GeogFromText("LINESTRING(3 8, 4.7 73.23)")`},
+		{value: LineString(nil), want: `NULL`},
+		{
+			value: Polygon{{{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 3}, {X: 0, Y: 1}}},
+			want:  `ST_GeogFromText("POLYGON((0 1, 1 2, 2 3, 0 1))")`,
+		},
+		{nebulaType: NebulaSdkTypeGeo, value: "POINT(3 8)", want: `ST_GeogFromText("POINT(3 8)")`},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, err := FormatSimpleValue(tt.nebulaType, reflect.ValueOf(tt.value))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGeographyValue(t *testing.T) {
+	coord := func(x, y float64) *nebulattypes.Coordinate {
+		return &nebulattypes.Coordinate{X: x, Y: y}
+	}
+	tests := []struct {
+		geo     *nebulattypes.Geography
+		want    any
+		wantWKT string
+	}{
+		{
+			geo:     &nebulattypes.Geography{PtVal: &nebulattypes.Point{Coord: coord(3, 8)}},
+			want:    Point{X: 3, Y: 8},
+			wantWKT: "POINT(3 8)",
+		},
+		{
+			geo:     &nebulattypes.Geography{LsVal: &nebulattypes.LineString{CoordList: []*nebulattypes.Coordinate{coord(3, 8), coord(4.7, 73.23)}}},
+			want:    LineString{{X: 3, Y: 8}, {X: 4.7, Y: 73.23}},
+			wantWKT: "LINESTRING(3 8, 4.7 73.23)",
+		},
+		{
+			geo:     &nebulattypes.Geography{PgVal: &nebulattypes.Polygon{CoordListList: [][]*nebulattypes.Coordinate{{coord(0, 1), coord(1, 2), coord(2, 3), coord(0, 1)}}}},
+			want:    Polygon{{{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 3}, {X: 0, Y: 1}}},
+			wantWKT: "POLYGON((0 1, 1 2, 2 3, 0 1))",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, geographyValue(tt.geo))
+			assert.Equal(t, tt.wantWKT, geographyWKT(tt.geo))
+		})
+	}
+}
+
+func TestBuiltinDataType(t *testing.T) {
+	type shop struct {
+		Location Point
+		Route    *LineString
+		Area     Polygon
+		Open     Duration
+		Area2    Polygon `norm:"type:geography"`
+	}
+	shopType := reflect.TypeOf(shop{})
+	tests := []struct {
+		field       string
+		wantType    string
+		wantSdkType string
+	}{
+		{field: "Location", wantType: "geography(point)", wantSdkType: NebulaSdkTypeGeo},
+		{field: "Route", wantType: "geography(linestring)", wantSdkType: NebulaSdkTypeGeo},
+		{field: "Area", wantType: "geography(polygon)", wantSdkType: NebulaSdkTypeGeo},
+		{field: "Open", wantType: "duration", wantSdkType: NebulaSdkTypeDuration},
+		{field: "Area2", wantType: "geography", wantSdkType: NebulaSdkTypeGeo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			field, _ := shopType.FieldByName(tt.field)
+			assert.Equal(t, tt.wantType, GetFieldDataType(field))
+			assert.Equal(t, tt.wantSdkType, GetValueSdkType(field))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+// Scanner is implemented by the types that decode themselves from the values returned by nebula graph, such as
+// enums, decimal amounts or json encoded props. ScanNebulaValue is called on the pointer to the dest value, the NULL
+// values are passed as well, so that the type can decide how to handle them.
+type Scanner interface {
+	ScanNebulaValue(value *nebula.ValueWrapper) error
+}
+
+// Valuer is implemented by the types that encode themselves into nGQL literals, such as "ACTIVE", 12.50 or
+// datetime("2024-01-01T00:00:00"). The returned literal is written into the statement as is, strings must be quoted.
+type Valuer interface {
+	NGQLValue() (string, error)
+}
+
+var (
+	scannerType = reflect.TypeOf((*Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*Valuer)(nil)).Elem()
+)
+
+type typeCodec struct {
+	scan   func(nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error
+	format func(value reflect.Value) (string, error)
+}
+
+var typeCodecs sync.Map // key: reflect.Type, value: *typeCodec
+
+// RegisterType registers the functions used to scan and format the values of type T, it is intended for the types
+// that cannot implement Scanner and Valuer, such as the types from third party packages. Either function may be nil,
+// in which case the default behavior is kept. The registered functions take precedence over the methods of the type,
+// registering the same type again replaces the functions.
+//
+//	resolver.RegisterType(func(value *nebula.ValueWrapper, dest *uuid.UUID) error {
+//		s, err := value.AsString()
+//		if err != nil {
+//			return err
+//		}
+//		*dest, err = uuid.Parse(s)
+//		return err
+//	}, func(value uuid.UUID) (string, error) {
+//		return strconv.Quote(value.String()), nil
+//	})
+func RegisterType[T any](scan func(value *nebula.ValueWrapper, dest *T) error, format func(value T) (string, error)) {
+	codec := &typeCodec{}
+	if scan != nil {
+		codec.scan = func(nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error {
+			return scan(nebulaValue, destValue.Addr().Interface().(*T))
+		}
+	}
+	if format != nil {
+		codec.format = func(value reflect.Value) (string, error) {
+			return format(value.Interface().(T))
+		}
+	}
+	typeCodecs.Store(reflect.TypeOf((*T)(nil)).Elem(), codec)
+}
+
+func getTypeCodec(t reflect.Type) *typeCodec {
+	if codec, ok := typeCodecs.Load(t); ok {
+		return codec.(*typeCodec)
+	}
+	return nil
+}
+
+// IsCustomFormat reports whether the values of the type are formatted by a registered function or the Valuer interface
+func IsCustomFormat(t reflect.Type) bool {
+	if codec := getTypeCodec(t); codec != nil && codec.format != nil {
+		return true
+	}
+	return t.Implements(valuerType) || reflect.PointerTo(t).Implements(valuerType)
+}
+
+// isCustomScan reports whether the values of the type are scanned by a registered function or the Scanner interface
+func isCustomScan(t reflect.Type) bool {
+	if codec := getTypeCodec(t); codec != nil && codec.scan != nil {
+		return true
+	}
+	return reflect.PointerTo(t).Implements(scannerType)
+}
+
+// scanCustomValue scans the value through the registered function or the Scanner interface of the dest type, false is
+// returned if the dest type is not customized
+func scanCustomValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value) (bool, error) {
+	destType := destValue.Type()
+	if destType.Kind() == reflect.Ptr && isCustomScan(destType.Elem()) {
+		if nebulaValue.GetType() == NebulaSdkTypeNull {
+			destValue.SetZero()
+			return true, nil
+		}
+		if destValue.IsNil() {
+			destValue.Set(reflect.New(destType.Elem()))
+		}
+		return scanCustomValue(nebulaValue, destValue.Elem())
+	}
+	if codec := getTypeCodec(destType); codec != nil && codec.scan != nil {
+		return true, codec.scan(nebulaValue, destValue)
+	}
+	if reflect.PointerTo(destType).Implements(scannerType) {
+		return true, destValue.Addr().Interface().(Scanner).ScanNebulaValue(nebulaValue)
+	}
+	return false, nil
+}
+
+// formatCustomValue formats the value through the registered function or the Valuer interface of its type, false is
+// returned if the type is not customized
+func formatCustomValue(value reflect.Value) (string, bool, error) {
+	// the nil pointers are always formatted as NULL
+	if !value.IsValid() || !value.CanInterface() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return "", false, nil
+	}
+	valueType := value.Type()
+	if codec := getTypeCodec(valueType); codec != nil && codec.format != nil {
+		return callFormat(codec.format, value)
+	}
+	if valueType.Implements(valuerType) {
+		return callFormat(func(v reflect.Value) (string, error) {
+			return v.Interface().(Valuer).NGQLValue()
+		}, value)
+	}
+	if reflect.PointerTo(valueType).Implements(valuerType) {
+		ptr := reflect.New(valueType)
+		ptr.Elem().Set(value)
+		return callFormat(func(v reflect.Value) (string, error) {
+			return v.Interface().(Valuer).NGQLValue()
+		}, ptr)
+	}
+	return "", false, nil
+}
+
+func callFormat(format func(value reflect.Value) (string, error), value reflect.Value) (string, bool, error) {
+	str, err := format(value)
+	if err != nil {
+		return "", true, fmt.Errorf("norm: format value failed, golang type: %s, %w", value.Type(), err)
+	}
+	return str, true, nil
+}
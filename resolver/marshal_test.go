@@ -0,0 +1,100 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+type status int
+
+const (
+	statusActive status = iota + 1
+	statusBanned
+)
+
+func (s status) NGQLValue() (string, error) {
+	switch s {
+	case statusActive:
+		return `"ACTIVE"`, nil
+	case statusBanned:
+		return `"BANNED"`, nil
+	}
+	return "", errors.New("unknown status")
+}
+
+type amount struct {
+	cents int64
+}
+
+func (a *amount) NGQLValue() (string, error) {
+	return fmt.Sprintf("%d.%02d", a.cents/100, a.cents%100), nil
+}
+
+func (a *amount) ScanNebulaValue(_ *nebula.ValueWrapper) error {
+	a.cents = 1250
+	return nil
+}
+
+type upperString string
+
+type code string
+
+func TestFormatCustomValue(t *testing.T) {
+	RegisterType[upperString](nil, func(value upperString) (string, error) {
+		return strconv.Quote(strings.ToUpper(string(value))), nil
+	})
+	tests := []struct {
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{value: statusActive, want: `"ACTIVE"`},
+		{value: &[]status{statusActive, statusBanned}, want: `["ACTIVE", "BANNED"]`},
+		{value: status(0), wantErr: true},
+		{value: amount{cents: 1250}, want: "12.50"},
+		{value: &amount{cents: 1250}, want: "12.50"},
+		{value: (*amount)(nil), want: "NULL"},
+		{value: map[string]any{"a": upperString("norm")}, want: `map{a: "NORM"}`},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, err := FormatSimpleValue("", reflect.ValueOf(tt.value))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+	assert.True(t, IsCustomFormat(reflect.TypeOf(statusActive)))
+	assert.True(t, IsCustomFormat(reflect.TypeOf(amount{})))
+	assert.True(t, IsCustomFormat(reflect.TypeOf(upperString(""))))
+	assert.False(t, IsCustomFormat(reflect.TypeOf("")))
+}
+
+func TestScanCustomValue(t *testing.T) {
+	RegisterType(func(_ *nebula.ValueWrapper, dest *code) error {
+		*dest = "NORM"
+		return nil
+	}, nil)
+	var dest struct {
+		Amount amount
+		Code   code
+	}
+	destValue := reflect.ValueOf(&dest).Elem()
+	if assert.NoError(t, ScanSimpleValue(&nebula.ValueWrapper{}, destValue.Field(0))) {
+		assert.Equal(t, int64(1250), dest.Amount.cents)
+	}
+	if assert.NoError(t, ScanSimpleValue(&nebula.ValueWrapper{}, destValue.Field(1))) {
+		assert.Equal(t, code("NORM"), dest.Code)
+	}
+}
@@ -0,0 +1,120 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/haysons/norm/internal/utils"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+// PathSchema parses the struct used to receive a path returned by nebula graph, the nodes of the path are assigned to
+// the slice field marked with path_nodes, and the relationships are assigned to the slice field marked with
+// path_relationships. The elements of the slices are scanned through VertexSchema.Scan and EdgeSchema.Scan.
+//
+//	type path struct {
+//		Nodes         []*player `norm:"path_nodes"`
+//		Relationships []follow  `norm:"path_relationships"`
+//	}
+type PathSchema struct {
+	nodesFieldIndex []int
+	nodeSchema      *VertexSchema
+	relsFieldIndex  []int
+	relSchema       *EdgeSchema
+}
+
+func ParsePath(destType reflect.Type) (*PathSchema, error) {
+	if destType.Kind() == reflect.Ptr {
+		destType = destType.Elem()
+	}
+	if destType.Kind() != reflect.Struct {
+		return nil, errors.New("norm: parse path failed, dest should be a struct or a struct pointer")
+	}
+	path := &PathSchema{}
+	for _, field := range getDestFields(destType) {
+		setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
+		_, isNodes := setting[TagSettingPathNodes]
+		_, isRels := setting[TagSettingPathRelationships]
+		if !isNodes && !isRels {
+			continue
+		}
+		if field.Type.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("norm: parse path failed, field %s should be a slice", field.Name)
+		}
+		elemType := field.Type.Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		isIface := elemType.Kind() == reflect.Interface && elemType.NumMethod() == 0
+		if isNodes && path.nodesFieldIndex == nil {
+			path.nodesFieldIndex = field.Index
+			if !isIface {
+				vertexSchema, err := ParseVertex(elemType)
+				if err != nil {
+					return nil, err
+				}
+				path.nodeSchema = vertexSchema
+			}
+		}
+		if isRels && path.relsFieldIndex == nil {
+			path.relsFieldIndex = field.Index
+			if !isIface {
+				edgeSchema, err := ParseEdge(elemType)
+				if err != nil {
+					return nil, err
+				}
+				path.relSchema = edgeSchema
+			}
+		}
+	}
+	if path.nodesFieldIndex == nil && path.relsFieldIndex == nil {
+		return nil, errors.New("norm: parse path failed, path must contains path_nodes field or path_relationships field")
+	}
+	return path, nil
+}
+
+// Scan assigns the path returned by the nebula graph to the path data in the business layer
+func (p *PathSchema) Scan(path *nebula.PathWrapper, destValue reflect.Value) error {
+	destValue = reflect.Indirect(destValue)
+	if !destValue.CanSet() {
+		return fmt.Errorf("norm: path schema scan dest value failed, %w", ErrValueCannotSet)
+	}
+	if p.nodesFieldIndex != nil {
+		nodes := path.GetNodes()
+		nodesValue := utils.FieldByIndexAlloc(destValue, p.nodesFieldIndex)
+		if !nodesValue.CanSet() {
+			return fmt.Errorf("norm: path schema scan nodes failed, %w", ErrValueCannotSet)
+		}
+		nodesValue.Set(reflect.MakeSlice(nodesValue.Type(), 0, len(nodes)))
+		err := utils.SliceSetElem(nodesValue, len(nodes), func(i int, elem reflect.Value) (bool, error) {
+			if p.nodeSchema == nil {
+				elem.Set(reflect.ValueOf(nodes[i]))
+				return true, nil
+			}
+			return true, p.nodeSchema.Scan(nodes[i], elem)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if p.relsFieldIndex != nil {
+		rels := path.GetRelationships()
+		relsValue := utils.FieldByIndexAlloc(destValue, p.relsFieldIndex)
+		if !relsValue.CanSet() {
+			return fmt.Errorf("norm: path schema scan rels failed, %w", ErrValueCannotSet)
+		}
+		relsValue.Set(reflect.MakeSlice(relsValue.Type(), 0, len(rels)))
+		err := utils.SliceSetElem(relsValue, len(rels), func(i int, elem reflect.Value) (bool, error) {
+			if p.relSchema == nil {
+				elem.Set(reflect.ValueOf(rels[i]))
+				return true, nil
+			}
+			return true, p.relSchema.Scan(rels[i], elem)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		dest          any
+		wantNodes     []int
+		wantNodeTags  []string
+		wantRels      []int
+		wantRelType   string
+		wantAnyValues bool
+		wantErr       bool
+	}{
+		{dest: path1{}, wantNodes: []int{0}, wantNodeTags: []string{"vertex_tag1"}, wantRels: []int{1}, wantRelType: "edge2"},
+		{dest: &path2{}, wantNodes: []int{1}, wantRels: []int{0}, wantAnyValues: true},
+		{dest: path3{}, wantNodes: nil, wantRels: []int{0, 0}, wantRelType: "edge1"},
+		{dest: path4{}, wantErr: true},
+		{dest: path5{}, wantErr: true},
+		{dest: path6{}, wantErr: true},
+		{dest: 1, wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, err := ParsePath(reflect.TypeOf(tt.dest))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantNodes, got.nodesFieldIndex)
+			assert.Equal(t, tt.wantRels, got.relsFieldIndex)
+			if tt.wantAnyValues {
+				assert.Nil(t, got.nodeSchema)
+				assert.Nil(t, got.relSchema)
+				return
+			}
+			if got.nodeSchema != nil {
+				tagNames := make([]string, 0)
+				for _, tag := range got.nodeSchema.GetTags() {
+					tagNames = append(tagNames, tag.TagName)
+				}
+				assert.Equal(t, tt.wantNodeTags, tagNames)
+			}
+			if assert.NotNil(t, got.relSchema) {
+				assert.Equal(t, tt.wantRelType, got.relSchema.GetTypeName())
+			}
+		})
+	}
+}
+
+type path1 struct {
+	Nodes []*vertex1 `norm:"path_nodes"`
+	Rels  []edge2    `norm:"path_relationships"`
+}
+
+type path2 struct {
+	Rels  []any `norm:"path_relationships"`
+	Nodes []any `norm:"path_nodes"`
+}
+
+type pathBase struct {
+	Rels []*edge1 `norm:"path_relationships"`
+}
+
+type path3 struct {
+	pathBase
+}
+
+type path4 struct {
+	Nodes []*vertex1
+}
+
+type path5 struct {
+	Nodes *vertex1 `norm:"path_nodes"`
+}
+
+type path6 struct {
+	Nodes []edge2 `norm:"path_nodes"`
+}
@@ -4,8 +4,6 @@ import (
 	"errors"
 	"reflect"
 	"sort"
-
-	"github.com/haysons/norm/internal/utils"
 )
 
 // RecordSchema parses the record structure provided by the business layer for subsequent assignment of the Record \
@@ -50,13 +48,7 @@ func getColName(field reflect.StructField) string {
 }
 
 func getDestFields(destType reflect.Type) []reflect.StructField {
-	fields := make([]reflect.StructField, 0)
-	for _, field := range utils.StructFields(destType) {
-		if !field.IsExported() || FieldIgnore(field) {
-			continue
-		}
-		fields = append(fields, field)
-	}
+	fields := StructFields(destType)
 	sort.Slice(fields, func(i, j int) bool {
 		if len(fields[i].Index) != len(fields[j].Index) {
 			return len(fields[i].Index) < len(fields[j].Index)
@@ -22,6 +22,10 @@ func TestParseRecord(t *testing.T) {
 			record: record2{},
 			want:   &RecordSchema{Name: "record2", colFieldIndex: map[string][]int{"col1": {1}, "names": {2}, "name": {0, 0}, "age": {0, 1}, "c": {0, 3}}},
 		},
+		{
+			record: record3{},
+			want:   &RecordSchema{Name: "record3", colFieldIndex: map[string][]int{"i_d": {2}, "name": {0, 0}, "age": {0, 1}, "c": {0, 3}, "x_name": {1, 0}, "x_age": {1, 1}, "x_c": {1, 3}}},
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
@@ -51,3 +55,9 @@ type record2 struct {
 	Col1  *record1 `norm:"col:col1"`
 	Names []string `norm:"col:names"`
 }
+
+type record3 struct {
+	*record1
+	Extra record1 `norm:"embedded;prefix:x_"`
+	ID    string
+}
@@ -27,6 +27,9 @@ const (
 	NebulaSdkTypeMap      = "map"
 	NebulaSdkTypeSet      = "set"
 	NebulaSdkTypeEmpty    = "empty"
+	NebulaSdkTypePath     = "path"
+	NebulaSdkTypeGeo      = "geography"
+	NebulaSdkTypeDuration = "duration"
 )
 
 var (
@@ -38,6 +41,7 @@ type Resolver struct {
 	vertexSchema map[string]*VertexSchema
 	edgeSchema   map[string]*EdgeSchema
 	recordSchema map[string]*RecordSchema
+	pathSchema   map[string]*PathSchema
 }
 
 func NewResolver() *Resolver {
@@ -45,6 +49,7 @@ func NewResolver() *Resolver {
 		vertexSchema: make(map[string]*VertexSchema),
 		edgeSchema:   make(map[string]*EdgeSchema),
 		recordSchema: make(map[string]*RecordSchema),
+		pathSchema:   make(map[string]*PathSchema),
 	}
 }
 
@@ -53,6 +58,11 @@ func (r *Resolver) ScanValue(nebulaValue *nebula.ValueWrapper, destValue reflect
 	if !destValue.CanSet() && destValue.Kind() != reflect.Map {
 		return fmt.Errorf("norm: scan dest value failed, %w", ErrValueCannotSet)
 	}
+	if destValue.CanSet() {
+		if ok, err := scanCustomValue(nebulaValue, destValue); ok {
+			return err
+		}
+	}
 	switch nebulaValue.GetType() {
 	case NebulaSdkTypeVertex:
 		vNode, _ := nebulaValue.AsNode()
@@ -80,6 +90,19 @@ func (r *Resolver) ScanValue(nebulaValue *nebula.ValueWrapper, destValue reflect
 			return edgeSchema.Scan(vRelationShip, destValue)
 		default:
 		}
+	case NebulaSdkTypePath:
+		vPath, _ := nebulaValue.AsPath()
+		destValue = utils.PtrValue(destValue)
+		switch destValue.Kind() {
+		case reflect.Struct:
+			destType := destValue.Type()
+			pathSchema, err := r.getPathSchema(destType)
+			if err != nil {
+				return err
+			}
+			return pathSchema.Scan(vPath, destValue)
+		default:
+		}
 	case NebulaSdkTypeList:
 		vList, _ := nebulaValue.AsList()
 		destValue = utils.PtrValue(destValue)
@@ -172,7 +195,7 @@ func (r *Resolver) ScanRecord(record *nebula.Record, colNames []string, destValu
 		if len(fieldIndex) == 0 {
 			continue
 		}
-		fieldValue := destValue.FieldByIndex(fieldIndex)
+		fieldValue := utils.FieldByIndexAlloc(destValue, fieldIndex)
 		if err = r.ScanValue(colValue, fieldValue); err != nil {
 			return err
 		}
@@ -219,6 +242,19 @@ func (r *Resolver) getEdgeSchema(destType reflect.Type) (*EdgeSchema, error) {
 	return edgeSchema, nil
 }
 
+func (r *Resolver) getPathSchema(destType reflect.Type) (*PathSchema, error) {
+	key := r.getSchemaKey(destType)
+	if p, ok := r.pathSchema[key]; ok {
+		return p, nil
+	}
+	pathSchema, err := ParsePath(destType)
+	if err != nil {
+		return nil, err
+	}
+	r.pathSchema[key] = pathSchema
+	return pathSchema, nil
+}
+
 func (r *Resolver) getSchemaKey(destType reflect.Type) string {
 	return destType.PkgPath() + "." + destType.Name()
 }
@@ -228,6 +264,9 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 	if !destValue.CanSet() {
 		return fmt.Errorf("norm: scan dest value failed, %w", ErrValueCannotSet)
 	}
+	if ok, err := scanCustomValue(nebulaValue, destValue); ok {
+		return err
+	}
 	if nebulaValue.GetType() == NebulaSdkTypeNull {
 		destValue.SetZero()
 		return nil
@@ -338,6 +377,14 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 			return nil
 		default:
 		}
+	case NebulaSdkTypeGeo:
+		switch destValue.Kind() {
+		case reflect.String:
+			geo, _ := nebulaValue.AsGeography()
+			destValue.SetString(geographyWKT(geo))
+			return nil
+		default:
+		}
 	case NebulaSdkTypeDatetime:
 		vDateTimeW, _ := nebulaValue.AsDateTime()
 		vDateTime, _ := vDateTimeW.GetLocalDateTimeWithTimezoneName(timezoneDefault.String())
@@ -361,6 +408,9 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 
 // FormatSimpleValue format variable values to nebula graph data format
 func FormatSimpleValue(sdkType string, value reflect.Value) (string, error) {
+	if str, ok, err := formatCustomValue(value); ok {
+		return str, err
+	}
 	switch value.Kind() {
 	case reflect.Bool:
 		switch sdkType {
@@ -412,6 +462,8 @@ func FormatSimpleValue(sdkType string, value reflect.Value) (string, error) {
 		case NebulaSdkTypeTime:
 			timeStr := `time("` + value.String() + `")`
 			return timeStr, nil
+		case NebulaSdkTypeGeo:
+			return geogFromText(value.String()), nil
 		}
 	case reflect.Struct:
 		switch sdkType {
@@ -598,10 +650,17 @@ func GetValueIface(nebulaValue *nebula.ValueWrapper) (any, error) {
 			res = append(res, vIface)
 		}
 		return res, nil
-	case "path":
+	case NebulaSdkTypePath:
 		return nebulaValue.AsPath()
-	case "geography":
-		return nebulaValue.AsGeography()
+	case NebulaSdkTypeGeo:
+		geo, _ := nebulaValue.AsGeography()
+		return geographyValue(geo), nil
+	case NebulaSdkTypeDuration:
+		var duration Duration
+		if err := duration.ScanNebulaValue(nebulaValue); err != nil {
+			return nil, err
+		}
+		return duration, nil
 	}
 	return nil, fmt.Errorf("norm: can not get nebula type %s interface value", nebulaValue.GetType())
 }
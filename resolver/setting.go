@@ -2,6 +2,7 @@ package resolver
 
 import (
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -9,20 +10,41 @@ import (
 )
 
 const (
-	TagSettingKey       = "norm"        // norm struct tag key
-	TagSettingColName   = "col"         // name of the field in the record
-	TagSettingVertexID  = "vertex_id"   // marks the field as a vertex ID
-	TagSettingEdgeSrcID = "edge_src_id" // marks the field as an edge source ID
-	TagSettingEdgeDstID = "edge_dst_id" // marks the field as an edge destination ID
-	TagSettingEdgeRank  = "edge_rank"   // marks the field as an edge rank
-	TagSettingPropName  = "prop"        // property name for a vertex or edge
-	TagSettingDataType  = "type"        // specifies the data type (see: https://docs.nebula-graph.com.cn/3.6.0/3.ngql-guide/3.data-types/1.numeric/)
-	TagSettingNotNull   = "not_null"    // declares the field as NOT NULL
-	TagSettingDefault   = "default"     // declares a default value for the field
-	TagSettingComment   = "comment"     // declares a comment/description for the field
-	TagSettingTTL       = "ttl"         // marks the field as TTL (time-to-live) for expiration
-	TagSettingIndex     = "index"       // defines index configuration on the field
-	TagSettingIgnore    = "-"           // norm will ignore this field
+	TagSettingKey        = "norm"        // norm struct tag key
+	TagSettingColName    = "col"         // name of the field in the record
+	TagSettingVertexID   = "vertex_id"   // marks the field as a vertex ID
+	TagSettingEdgeSrcID  = "edge_src_id" // marks the field as an edge source ID
+	TagSettingEdgeDstID  = "edge_dst_id" // marks the field as an edge destination ID
+	TagSettingEdgeRank   = "edge_rank"   // marks the field as an edge rank
+	TagSettingPropName   = "prop"        // property name for a vertex or edge
+	TagSettingDataType   = "type"        // specifies the data type (see: https://docs.nebula-graph.com.cn/3.6.0/3.ngql-guide/3.data-types/1.numeric/)
+	TagSettingNotNull    = "not_null"    // declares the field as NOT NULL
+	TagSettingDefault    = "default"     // declares a default value for the field
+	TagSettingComment    = "comment"     // declares a comment/description for the field
+	TagSettingTTL        = "ttl"         // marks the field as TTL (time-to-live) for expiration
+	TagSettingIndex      = "index"       // defines index configuration on the field
+	TagSettingIgnore     = "-"           // norm will ignore this field
+	TagSettingEmbedded   = "embedded"    // promotes the fields of the struct field into the owning struct
+	TagSettingPrefix     = "prefix"      // prefix of the prop names and col names of the embedded struct fields
+	TagSettingSoftDelete = "soft_delete" // marks the prop as the time of soft deletion, the data is marked instead of deleted
+
+	// autoCreateTime fills the prop with the current time when it is inserted with the zero value, UPSERT fills it only
+	// when it inserts the vertex or edge. autoUpdateTime overwrites the prop with the current time whenever it is
+	// updated or upserted, whatever value the field holds, but only fills the zero value when it is inserted.
+	TagSettingAutoCreateTime = "autocreatetime"
+	TagSettingAutoUpdateTime = "autoupdatetime"
+
+	TagSettingPathNodes         = "path_nodes"         // marks the field as the nodes of a path
+	TagSettingPathRelationships = "path_relationships" // marks the field as the relationships of a path
+)
+
+// variants of autoCreateTime and autoUpdateTime, such as `norm:"autoUpdateTime:milli"`, the current time is filled in as
+// unix seconds, unix milliseconds or datetime, by default time.Time fields are filled with datetime and the integer
+// fields with unix seconds
+const (
+	AutoTimeUnix     = "unix"
+	AutoTimeMilli    = "milli"
+	AutoTimeDatetime = "datetime"
 )
 
 func ParseTagSetting(s string) map[string]string {
@@ -70,10 +92,15 @@ func GetValueSdkType(field reflect.StructField) string {
 		return NebulaSdkTypeTime
 	case "datetime", "timestamp":
 		return NebulaSdkTypeDatetime
+	case "duration":
+		return NebulaSdkTypeDuration
 	default:
 		if strings.HasPrefix(dataTypeLower, "fixed_string") {
 			return NebulaSdkTypeString
 		}
+		if strings.HasPrefix(dataTypeLower, "geography") {
+			return NebulaSdkTypeGeo
+		}
 		return dataTypeRaw
 	}
 }
@@ -85,6 +112,9 @@ func GetFieldDataType(field reflect.StructField) string {
 		return dataType
 	}
 	fieldType := field.Type
+	if dataType = builtinDataType(fieldType); dataType != "" {
+		return dataType
+	}
 	switch fieldType.Kind() {
 	case reflect.Bool:
 		return "bool"
@@ -114,6 +144,24 @@ func GetFieldDataType(field reflect.StructField) string {
 	return ""
 }
 
+// builtinDataType returns the data type of the types provided by norm, such as Point and Duration
+func builtinDataType(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case reflect.TypeOf(Point{}):
+		return "geography(point)"
+	case reflect.TypeOf(LineString{}):
+		return "geography(linestring)"
+	case reflect.TypeOf(Polygon{}):
+		return "geography(polygon)"
+	case reflect.TypeOf(Duration{}):
+		return "duration"
+	}
+	return ""
+}
+
 func IsFieldNotNull(field reflect.StructField) bool {
 	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
 	notNull := setting[TagSettingNotNull]
@@ -135,6 +183,95 @@ func GetFieldTTL(field reflect.StructField) string {
 	return setting[TagSettingTTL]
 }
 
+var schemaTTLPattern = regexp.MustCompile(`ttl_duration = (\d+), ttl_col = "([^"]*)"`)
+
+// ParseSchemaTTL parses the ttl col and ttl duration from the result of SHOW CREATE TAG or SHOW CREATE EDGE, such as
+// ttl_duration = 100, ttl_col = "create_time", an empty col is returned if there is no ttl
+func ParseSchemaTTL(createSchema string) (col string, duration string) {
+	matches := schemaTTLPattern.FindStringSubmatch(createSchema)
+	if matches == nil || matches[2] == "" {
+		return "", ""
+	}
+	return matches[2], matches[1]
+}
+
+var schemaCommentPattern = regexp.MustCompile(`\bcomment = ("(?:[^"\\]|\\.)*")\s*$`)
+
+// ParseSchemaComment parses the comment of the tag or edge from the result of SHOW CREATE TAG or SHOW CREATE EDGE, such
+// as comment = "the players", an empty string is returned if there is no comment
+func ParseSchemaComment(createSchema string) string {
+	matches := schemaCommentPattern.FindStringSubmatch(createSchema)
+	if matches == nil {
+		return ""
+	}
+	comment, err := strconv.Unquote(matches[1])
+	if err != nil {
+		return strings.Trim(matches[1], `"`)
+	}
+	return comment
+}
+
+// GetFieldAutoTime returns the variant of the autoCreateTime or autoUpdateTime setting given by key, an empty string is
+// returned if the setting is absent or the field cannot hold the current time
+func GetFieldAutoTime(field reflect.StructField, key string) string {
+	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
+	variant, ok := setting[key]
+	if !ok {
+		return ""
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	isTime := fieldType == reflect.TypeOf(time.Time{})
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+	default:
+		if !isTime {
+			return ""
+		}
+	}
+	switch strings.ToLower(variant) {
+	case AutoTimeUnix:
+		return AutoTimeUnix
+	case AutoTimeMilli:
+		return AutoTimeMilli
+	case AutoTimeDatetime:
+		return AutoTimeDatetime
+	}
+	if isTime {
+		return AutoTimeDatetime
+	}
+	return AutoTimeUnix
+}
+
+// AutoTimeValue returns the value of the given type holding the time now in the variant of the auto time setting, the
+// time fields always hold the time itself in the configured timezone
+func AutoTimeValue(fieldType reflect.Type, variant string, now time.Time) reflect.Value {
+	isPtr := fieldType.Kind() == reflect.Ptr
+	if isPtr {
+		fieldType = fieldType.Elem()
+	}
+	value := reflect.New(fieldType).Elem()
+	switch {
+	case fieldType == reflect.TypeOf(time.Time{}):
+		value.Set(reflect.ValueOf(now.In(timezoneDefault)))
+	case variant == AutoTimeMilli:
+		value.Set(reflect.ValueOf(now.UnixMilli()).Convert(fieldType))
+	default:
+		value.Set(reflect.ValueOf(now.Unix()).Convert(fieldType))
+	}
+	if isPtr {
+		return value.Addr()
+	}
+	return value
+}
+
+func IsFieldSoftDelete(field reflect.StructField) bool {
+	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
+	return setting[TagSettingSoftDelete] != ""
+}
+
 type IndexField struct {
 	Name     string
 	Prop     string
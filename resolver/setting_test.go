@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -64,3 +65,70 @@ func TestGetFieldIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFieldAutoTime(t *testing.T) {
+	timeType := reflect.TypeOf(time.Time{})
+	int64Type := reflect.TypeOf(int64(0))
+	tests := []struct {
+		field reflect.StructField
+		key   string
+		want  string
+	}{
+		{field: reflect.StructField{Name: "CreatedAt", Type: timeType}, key: TagSettingAutoCreateTime, want: ""},
+		{field: reflect.StructField{Name: "CreatedAt", Type: timeType, Tag: `norm:"autoCreateTime"`}, key: TagSettingAutoCreateTime, want: AutoTimeDatetime},
+		{field: reflect.StructField{Name: "CreatedAt", Type: reflect.PointerTo(timeType), Tag: `norm:"autoCreateTime"`}, key: TagSettingAutoCreateTime, want: AutoTimeDatetime},
+		{field: reflect.StructField{Name: "CreatedAt", Type: timeType, Tag: `norm:"autoCreateTime"`}, key: TagSettingAutoUpdateTime, want: ""},
+		{field: reflect.StructField{Name: "UpdatedAt", Type: int64Type, Tag: `norm:"autoUpdateTime"`}, key: TagSettingAutoUpdateTime, want: AutoTimeUnix},
+		{field: reflect.StructField{Name: "UpdatedAt", Type: int64Type, Tag: `norm:"autoUpdateTime:milli"`}, key: TagSettingAutoUpdateTime, want: AutoTimeMilli},
+		{field: reflect.StructField{Name: "UpdatedAt", Type: reflect.TypeOf(""), Tag: `norm:"autoUpdateTime"`}, key: TagSettingAutoUpdateTime, want: ""},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, GetFieldAutoTime(tt.field, tt.key))
+		})
+	}
+}
+
+func TestAutoTimeValue(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, now.Unix(), AutoTimeValue(reflect.TypeOf(int64(0)), AutoTimeUnix, now).Interface())
+	assert.Equal(t, int(now.UnixMilli()), AutoTimeValue(reflect.TypeOf(0), AutoTimeMilli, now).Interface())
+	assert.True(t, now.Equal(AutoTimeValue(reflect.TypeOf(time.Time{}), AutoTimeDatetime, now).Interface().(time.Time)))
+	ptr := AutoTimeValue(reflect.TypeOf(&now), AutoTimeDatetime, now).Interface().(*time.Time)
+	assert.True(t, now.Equal(*ptr))
+}
+
+func TestParseSchemaComment(t *testing.T) {
+	tests := []struct {
+		createSchema string
+		want         string
+	}{
+		{createSchema: "CREATE TAG `player` (\n `name` string NULL COMMENT \"name\"\n) ttl_duration = 0, ttl_col = \"\", comment = \"the players\"", want: "the players"},
+		{createSchema: "CREATE EDGE `follow` (\n `degree` int64 NULL\n) ttl_duration = 0, ttl_col = \"\", comment = \"say \\\"hi\\\"\"", want: `say "hi"`},
+		{createSchema: "CREATE TAG `player` (\n `name` string NULL COMMENT \"name\"\n) ttl_duration = 0, ttl_col = \"\"", want: ""},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseSchemaComment(tt.createSchema))
+		})
+	}
+}
+
+func TestParseSchemaTTL(t *testing.T) {
+	tests := []struct {
+		createSchema string
+		col          string
+		duration     string
+	}{
+		{createSchema: "CREATE TAG `player` (\n `name` string NULL,\n `created_at` timestamp NULL\n) ttl_duration = 100, ttl_col = \"created_at\", comment = \"\"", col: "created_at", duration: "100"},
+		{createSchema: "CREATE EDGE `follow` (\n `degree` int64 NULL\n) ttl_duration = 0, ttl_col = \"\", comment = \"\"", col: "", duration: ""},
+		{createSchema: "CREATE TAG `player` (\n `name` string NULL\n)", col: "", duration: ""},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			col, duration := ParseSchemaTTL(tt.createSchema)
+			assert.Equal(t, tt.col, col)
+			assert.Equal(t, tt.duration, duration)
+		})
+	}
+}
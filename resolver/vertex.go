@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
+	"github.com/haysons/norm/internal/utils"
 	nebula "github.com/vesoft-inc/nebula-go/v3"
 )
 
@@ -26,6 +28,11 @@ type VertexTagNamer interface {
 	VertexTagName() string
 }
 
+// VertexTagCommenter the tag structure that implements this interface has a comment, which is set when the tag is created
+type VertexTagCommenter interface {
+	VertexTagComment() string
+}
+
 type VIDType int
 
 const (
@@ -67,7 +74,8 @@ func ParseVertex(destType reflect.Type) (*VertexSchema, error) {
 	if !isTag {
 		for i := 0; i < destType.NumField(); i++ {
 			field := destType.Field(i)
-			if field.Anonymous || !field.IsExported() || FieldIgnore(field) {
+			// the tags may be embedded anonymously, whose promoted fields can be set even if the tag is unexported
+			if (!field.Anonymous && !field.IsExported()) || FieldIgnore(field) {
 				continue
 			}
 			if _, err := vertex.parseTag(destType.Field(i).Type, i); err != nil {
@@ -130,6 +138,9 @@ func (v *VertexSchema) parseTag(destType reflect.Type, superIndex int) (bool, er
 			props:      make([]*Prop, 0),
 			propByName: make(map[string]*Prop),
 		}
+		if tagCommenter, ok := destValue.(VertexTagCommenter); ok {
+			tag.Comment = tagCommenter.VertexTagComment()
+		}
 		v.tagByName[tagName] = tag
 		v.tags = append(v.tags, tag)
 	}
@@ -146,21 +157,27 @@ func (v *VertexSchema) parseTag(destType reflect.Type, superIndex int) (bool, er
 		propDefault := GetFieldDefault(structField)
 		comment := GetFieldComment(structField)
 		ttl := GetFieldTTL(structField)
+		softDelete := IsFieldSoftDelete(structField)
+		autoCreateTime := GetFieldAutoTime(structField, TagSettingAutoCreateTime)
+		autoUpdateTime := GetFieldAutoTime(structField, TagSettingAutoUpdateTime)
 		index := GetFieldIndex(structField, tagName, propName, dataType)
 		// tag may exist in a multi-level structure, the index value of the field needs to be added to the index value of the parent field
 		if superIndex >= 0 {
 			structField.Index = append([]int{superIndex}, structField.Index...)
 		}
 		prop := &Prop{
-			Name:        propName,
-			StructField: structField,
-			Type:        structField.Type,
-			SdkType:     sdkType,
-			DataType:    dataType,
-			NotNull:     notNull,
-			Default:     propDefault,
-			Comment:     comment,
-			TTL:         ttl,
+			Name:           propName,
+			StructField:    structField,
+			Type:           structField.Type,
+			SdkType:        sdkType,
+			DataType:       dataType,
+			NotNull:        notNull,
+			Default:        propDefault,
+			Comment:        comment,
+			TTL:            ttl,
+			SoftDelete:     softDelete,
+			AutoCreateTime: autoCreateTime,
+			AutoUpdateTime: autoUpdateTime,
 		}
 		if _, ok := v.tagByName[tagName].propByName[propName]; ok {
 			continue
@@ -217,7 +234,7 @@ func (v *VertexSchema) Scan(node *nebula.Node, destValue reflect.Value) error {
 	// if a vid field exists in the structure, it is assigned to it
 	if v.vidFieldIndex != nil {
 		vid := node.GetID()
-		if err := ScanSimpleValue(&vid, destValue.FieldByIndex(v.vidFieldIndex)); err != nil {
+		if err := ScanSimpleValue(&vid, utils.FieldByIndexAlloc(destValue, v.vidFieldIndex)); err != nil {
 			return err
 		}
 	}
@@ -231,7 +248,7 @@ func (v *VertexSchema) Scan(node *nebula.Node, destValue reflect.Value) error {
 			if !ok {
 				continue
 			}
-			if err = ScanSimpleValue(propValue, destValue.FieldByIndex(prop.StructField.Index)); err != nil {
+			if err = ScanSimpleValue(propValue, utils.FieldByIndexAlloc(destValue, prop.StructField.Index)); err != nil {
 				return err
 			}
 		}
@@ -241,6 +258,7 @@ func (v *VertexSchema) Scan(node *nebula.Node, destValue reflect.Value) error {
 
 type VertexTag struct {
 	TagName     string
+	Comment     string
 	props       []*Prop
 	propByName  map[string]*Prop // key: prop name
 	indexNames  []string
@@ -257,6 +275,26 @@ type Prop struct {
 	Default     string
 	Comment     string
 	TTL         string
+	SoftDelete  bool
+	// AutoCreateTime and AutoUpdateTime are the variants of the auto time settings, empty if they are not set
+	AutoCreateTime string
+	AutoUpdateTime string
+}
+
+// DefaultNGQL returns the default value of the prop as it is written in nGQL, the default of a string or fixed_string
+// prop is written as a quoted string literal, the value already quoted by double or single quotes is requoted
+func (p *Prop) DefaultNGQL() string {
+	dataType := strings.ToLower(p.DataType)
+	if p.Default == "" || (dataType != "string" && !strings.HasPrefix(dataType, "fixed_string")) {
+		return p.Default
+	}
+	value := p.Default
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		value = value[1 : len(value)-1]
+	} else if unquoted, err := strconv.Unquote(value); err == nil && value[0] == '"' {
+		value = unquoted
+	}
+	return strconv.Quote(value)
 }
 
 // GetProps get all attributes of the tag
@@ -264,6 +302,16 @@ func (t *VertexTag) GetProps() []*Prop {
 	return t.props
 }
 
+// GetSoftDeleteProp get the prop marking the soft deletion of the tag, nil is returned if there is none
+func (t *VertexTag) GetSoftDeleteProp() *Prop {
+	for _, prop := range t.props {
+		if prop.SoftDelete {
+			return prop
+		}
+	}
+	return nil
+}
+
 // SetProps set attributes of the tag
 func (t *VertexTag) SetProps(props ...*Prop) {
 	if t.propByName == nil {
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -78,6 +79,26 @@ func TestParseVertex(t *testing.T) {
 				{"age", []int{2}, "int"},
 			},
 		}},
+		{dest: &vertex8{}, wantVIDType: VIDTypeString, wantVIDIndex: []int{0}, wantVIDMethodIndex: 0, wantVIDReceiverIsPtr: false, wantTag: map[string][]prop{
+			"vertex_tag8": {
+				{"name", []int{3}, "string"},
+				{"created_at", []int{1, 0}, "datetime"},
+				{"version", []int{1, 1}, "int"},
+				{"audit_created_at", []int{2, 0}, "datetime"},
+				{"audit_version", []int{2, 1}, "int"},
+			},
+		}},
+		{dest: vertex9{}, wantVIDType: VIDTypeString, wantVIDIndex: []int{2}, wantVIDMethodIndex: 1, wantVIDReceiverIsPtr: false, wantTag: map[string][]prop{
+			"vertex_tag1": {
+				{"name", []int{0, 0}, "string"},
+				{"age", []int{0, 1}, "int"},
+			},
+			"vertex_tag2": {
+				{"name", []int{1, 0}, "string"},
+				{"age", []int{1, 1}, "int"},
+				{"gender", []int{1, 2}, "string"},
+			},
+		}},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
@@ -294,3 +315,33 @@ type vertex7 struct {
 	Name string `norm:"prop:name"`
 	Age  int    `norm:"prop:age"`
 }
+
+type baseModel struct {
+	CreatedAt time.Time
+	Version   int64
+}
+
+type vertex8 struct {
+	VID string `norm:"vertex_id"`
+	baseModel
+	Audit *baseModel `norm:"embedded;prefix:audit_"`
+	Name  string
+}
+
+func (v vertex8) VertexID() string {
+	return v.VID
+}
+
+func (v vertex8) VertexTagName() string {
+	return "vertex_tag8"
+}
+
+type vertex9 struct {
+	vertex1
+	*vertex2
+	ID string `norm:"vertex_id"`
+}
+
+func (v vertex9) VertexID() string {
+	return v.ID
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/haysons/norm/clause"
 	"github.com/haysons/norm/internal/utils"
 	"github.com/haysons/norm/logger"
 	"github.com/haysons/norm/resolver"
@@ -18,37 +20,38 @@ func (db *DB) NGQL() (string, error) {
 	return tx.Statement.NGQL()
 }
 
-// RawResult exec the statement and return the result of nebula-go directly
-func (db *DB) RawResult() (*nebula.ResultSet, error) {
-	nGQL, err := db.NGQL()
+// NGQLWithParams get the generated statement along with the parameters it references, the parameters are only
+// present when the statement is parameterized
+func (db *DB) NGQLWithParams() (string, map[string]any, error) {
+	tx := db.getInstance()
+	nGQL, err := tx.Statement.NGQL()
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
-	res, err := db.sessionPool.Execute(nGQL)
-	db.conf.logger.Trace(context.TODO(), &logger.TraceRecord{NGQL: nGQL, Err: err})
-	return res, err
+	params, err := tx.Statement.Params()
+	if err != nil {
+		return "", nil, err
+	}
+	return nGQL, params, nil
+}
+
+// RawResult exec the statement and return the result of nebula-go directly
+func (db *DB) RawResult() (*nebula.ResultSet, error) {
+	tx := db.getInstance()
+	return tx.callbacks.processors[operationKind(tx.Statement)].execute(tx)
 }
 
 // Exec the statement, but don't care about the result as long as it is used for insert, update, delete operations
 func (db *DB) Exec() error {
-	nGQL, err := db.NGQL()
-	if err != nil {
-		return err
-	}
-	res, err := db.sessionPool.Execute(nGQL)
-	db.conf.logger.Trace(context.TODO(), &logger.TraceRecord{NGQL: nGQL, Err: err})
-	if err != nil {
-		return err
-	}
-	if !res.IsSucceed() {
-		return fmt.Errorf("norm: result is not succeed, err code: %d, msg: %s", res.GetErrorCode(), res.GetErrorMsg())
-	}
-	return nil
+	tx := db.getInstance()
+	_, err := tx.succeededResult()
+	return err
 }
 
 // Find exec the statement and assign the returned result to the dest variable
 func (db *DB) Find(dest any) error {
-	rawRes, err := db.RawResult()
+	tx := db.getInstance()
+	rawRes, err := tx.succeededResult()
 	if err != nil {
 		return err
 	}
@@ -57,7 +60,8 @@ func (db *DB) Find(dest any) error {
 
 // FindCol parse one column of the result, it is used to easily get the value of a field
 func (db *DB) FindCol(col string, dest any) error {
-	rawRes, err := db.RawResult()
+	tx := db.getInstance()
+	rawRes, err := tx.succeededResult()
 	if err != nil {
 		return err
 	}
@@ -69,15 +73,10 @@ func (db *DB) FindCol(col string, dest any) error {
 func (db *DB) Take(dest any) error {
 	tx := db.getInstance()
 	lastPart := tx.Statement.LastPart()
-	if lastPart.GetType() != statement.PartTypeLimit {
+	if lastPart.GetType() != statement.PartTypeLimit && !lastPart.HasClause(clause.LimitName) {
 		tx.Statement.Limit(1)
 	}
-	nGQL, err := tx.Statement.NGQL()
-	if err != nil {
-		return err
-	}
-	rawRes, err := db.sessionPool.Execute(nGQL)
-	db.conf.logger.Trace(context.TODO(), &logger.TraceRecord{NGQL: nGQL, Err: err})
+	rawRes, err := tx.succeededResult()
 	if err != nil {
 		return err
 	}
@@ -89,19 +88,89 @@ func (db *DB) Take(dest any) error {
 func (db *DB) TakeCol(col string, dest any) error {
 	tx := db.getInstance()
 	lastPart := tx.Statement.LastPart()
-	if lastPart.GetType() != statement.PartTypeLimit {
+	if lastPart.GetType() != statement.PartTypeLimit && !lastPart.HasClause(clause.LimitName) {
 		tx.Statement.Limit(1)
 	}
-	nGQL, err := tx.Statement.NGQL()
+	rawRes, err := tx.succeededResult()
 	if err != nil {
 		return err
 	}
-	rawRes, err := db.sessionPool.Execute(nGQL)
-	db.conf.logger.Trace(context.TODO(), &logger.TraceRecord{NGQL: nGQL, Err: err})
+	return pluck(rawRes, col, dest, true)
+}
+
+// succeededResult executes the statement, a *NebulaError is returned if the execution is not succeeded
+func (db *DB) succeededResult() (*nebula.ResultSet, error) {
+	res, err := db.RawResult()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if !res.IsSucceed() {
+		nGQL, _ := db.Statement.NGQL()
+		return nil, newNebulaError(res, nGQL, db.SpaceName())
+	}
+	return res, nil
+}
+
+// execute the statement through the session pool and trace it. If the context of the DB is done before the result
+// is returned, the wait is abandoned and ctx.Err() is returned, while the statement itself may still be completed
+// by the server.
+func (db *DB) execute(nGQL string, params map[string]any) (*nebula.ResultSet, error) {
+	ctx := db.Context()
+	begin := time.Now()
+	res, err := db.executeContext(ctx, nGQL, params)
+	record := &logger.TraceRecord{
+		NGQL:      nGQL,
+		Params:    params,
+		Err:       err,
+		Begin:     begin,
+		Elapsed:   time.Since(begin),
+		SpaceName: db.conf.SpaceName,
+		Operation: string(operationKind(db.Statement)),
+		Caller:    utils.FileWithLineNum(),
+	}
+	if res != nil {
+		record.Latency = time.Duration(res.GetLatency()) * time.Microsecond
+		record.RowCount = res.GetRowSize()
+		if spaceName := res.GetSpaceName(); spaceName != "" {
+			record.SpaceName = spaceName
+		}
+	}
+	db.conf.logger.Trace(ctx, record)
+	return res, err
+}
+
+func (db *DB) executeContext(ctx context.Context, nGQL string, params map[string]any) (*nebula.ResultSet, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if ctx.Done() == nil {
+		return db.sessionPool.ExecuteWithParameter(nGQL, params)
+	}
+	type result struct {
+		res *nebula.ResultSet
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		var r result
+		// the deadline is also sent to the server, so that it will not keep executing a statement nobody waits for
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout := time.Until(deadline).Milliseconds()
+			if timeout <= 0 {
+				timeout = 1
+			}
+			r.res, r.err = db.sessionPool.ExecuteWithParameterTimeout(nGQL, params, timeout)
+		} else {
+			r.res, r.err = db.sessionPool.ExecuteWithParameter(nGQL, params)
+		}
+		resCh <- r
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-resCh:
+		return r.res, r.err
 	}
-	return pluck(rawRes, col, dest, true)
 }
 
 // Scan assign the results to the target variable
@@ -111,7 +180,7 @@ func Scan(rawRes *nebula.ResultSet, dest any) error {
 
 func scan(rawRes *nebula.ResultSet, dest any, raiseNotFound bool) error {
 	if !rawRes.IsSucceed() {
-		return fmt.Errorf("norm: result is not succeed, err code: %d, msg: %s", rawRes.GetErrorCode(), rawRes.GetErrorMsg())
+		return newNebulaError(rawRes, "", "")
 	}
 	if rawRes.GetRowSize() == 0 {
 		if raiseNotFound {
@@ -206,7 +275,7 @@ func Pluck(rawRes *nebula.ResultSet, col string, dest any) error {
 
 func pluck(rawRes *nebula.ResultSet, col string, dest any, raiseNotFound bool) error {
 	if !rawRes.IsSucceed() {
-		return fmt.Errorf("norm: result is not succeed, err code: %d, msg: %s", rawRes.GetErrorCode(), rawRes.GetErrorMsg())
+		return newNebulaError(rawRes, "", "")
 	}
 	if rawRes.GetRowSize() == 0 {
 		if raiseNotFound {
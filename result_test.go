@@ -0,0 +1,40 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/haysons/norm/logger"
+	"github.com/haysons/norm/statement"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteContextDone(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	tests := []struct {
+		ctx  context.Context
+		want error
+	}{
+		{ctx: canceled, want: context.Canceled},
+		{ctx: expired, want: context.DeadlineExceeded},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			// the session pool is nil, it panics if the statement reaches the pool
+			db := &DB{Statement: statement.New(), conf: &Config{SpaceName: "test"}, callbacks: newCallbacks(), clone: 1}
+			res, err := db.executeContext(tt.ctx, "SHOW TAGS", nil)
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.want))
+
+			db.conf.logger = logger.Default.LogMode(logger.SilentLevel)
+			err = db.WithContext(tt.ctx).Raw("SHOW TAGS").Exec()
+			assert.True(t, errors.Is(err, tt.want))
+		})
+	}
+}
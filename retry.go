@@ -0,0 +1,156 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/vesoft-inc/fbthrift/thrift/lib/go/thrift"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
+)
+
+// RetryPolicy decides whether and when a failed statement is executed again. Query statements are retried by
+// default, while the statements that write data or change the schema are only retried when they are explicitly marked
+// by DB.Idempotent. The statements written by Raw are classified by their leading keywords, they are retried only when
+// all of them read data, such as GO, FETCH, LOOKUP and MATCH. Only the transient failures are retried, that is the
+// connection failures, leader changes and rpc timeouts, never the semantic or syntax errors of the statement.
+type RetryPolicy struct {
+	// MaxAttempts the max number of executions including the first one, a value less than 2 disables retrying
+	MaxAttempts int
+
+	// InitialBackoff the wait before the first retry, it doubles for each subsequent retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff the upper limit of the wait before a retry, zero means no limit
+	MaxBackoff time.Duration
+
+	// Jitter randomizes the wait by the given fraction, e.g. 0.2 means the wait varies within ±20%
+	Jitter float64
+
+	// RetryableCodes the error codes of the result set that are regarded as transient,
+	// DefaultRetryableCodes is used if it is empty
+	RetryableCodes []nebula.ErrorCode
+
+	// Classifier replaces the default classification when it is set, it receives the error returned by the session
+	// pool and the result set, and reports whether the statement should be retried. By default an error is retried
+	// only if it is caused by the connection to the graph service, see RetryableCodes for the result set
+	Classifier func(err error, res *nebula.ResultSet) bool
+}
+
+// DefaultRetryableCodes the error codes caused by the connection failures, session expiry, leader changes and rpc
+// failures, which are likely to succeed when executed again
+var DefaultRetryableCodes = []nebula.ErrorCode{
+	nebula.ErrorCode_E_DISCONNECTED,
+	nebula.ErrorCode_E_FAIL_TO_CONNECT,
+	nebula.ErrorCode_E_RPC_FAILURE,
+	nebula.ErrorCode(nebulattypes.ErrorCode_E_LEADER_CHANGED),
+	nebula.ErrorCode_E_SESSION_INVALID,
+	nebula.ErrorCode_E_SESSION_TIMEOUT,
+}
+
+// DefaultRetryPolicy the retry policy used when no policy is specified by WithRetryPolicy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Jitter:         0.2,
+}
+
+// shouldRetry reports whether the result of an execution is a transient failure
+func (p *RetryPolicy) shouldRetry(err error, res *nebula.ResultSet) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if p.Classifier != nil {
+		return p.Classifier(err, res)
+	}
+	if err != nil {
+		return isTransientError(err)
+	}
+	if res == nil || res.IsSucceed() {
+		return false
+	}
+	codes := p.RetryableCodes
+	if len(codes) == 0 {
+		codes = DefaultRetryableCodes
+	}
+	for _, code := range codes {
+		if res.GetErrorCode() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isTransientError reports whether the error returned by the session pool is caused by a failed connection or a timed
+// out rpc rather than by the statement itself
+func isTransientError(err error) bool {
+	var transportErr thrift.TransportException
+	if errors.As(err, &transportErr) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	// the session pool reports that no connection or session is available by the message only
+	msg := err.Error()
+	return strings.HasPrefix(msg, "failed to create a net.Conn-backed Transport") ||
+		strings.HasPrefix(msg, "failed to get session")
+}
+
+// backoff returns the wait before the given retry, which starts from 1
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	wait := float64(p.InitialBackoff) * math.Pow(2, float64(retry-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(wait)
+}
+
+// Idempotent marks the statement as idempotent, so that it is retried by the retry policy even if it writes data
+func (db *DB) Idempotent() (tx *DB) {
+	tx = db.getInstance()
+	tx.idempotent = true
+	return
+}
+
+// retryable reports whether the statement of the DB may be executed more than once, the statements that write data or
+// change the schema are retried only if they are marked by Idempotent, and the raw statements only if all of them are
+// known to read data
+func (db *DB) retryable() bool {
+	if db.idempotent {
+		return true
+	}
+	if raw := db.Statement.RawNGQL(); raw != "" {
+		_, query := rawOperationKind(raw)
+		return query
+	}
+	return db.Operation() == OperationQuery
+}
+
+// sleepContext waits for the given duration, it returns early with ctx.Err() if the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,131 @@
+package norm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/haysons/norm/statement"
+	"github.com/stretchr/testify/assert"
+	"github.com/vesoft-inc/fbthrift/thrift/lib/go/thrift"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulattypes "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
+)
+
+type retryPlayer struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+}
+
+func (p retryPlayer) VertexID() string {
+	return p.VID
+}
+
+func (p retryPlayer) VertexTagName() string {
+	return "player"
+}
+
+func genResultSet(t *testing.T, code nebula.ErrorCode) *nebula.ResultSet {
+	res, err := nebula.GenResultSet(&graph.ExecutionResponse{ErrorCode: nebulattypes.ErrorCode(code), ErrorMsg: []byte("failed")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		policy RetryPolicy
+		err    error
+		code   nebula.ErrorCode
+		want   bool
+	}{
+		{policy: DefaultRetryPolicy, code: nebula.ErrorCode_SUCCEEDED, want: false},
+		{policy: DefaultRetryPolicy, err: errors.New("failed to parse params: unsupported type"), want: false},
+		{policy: DefaultRetryPolicy, err: fmt.Errorf("write: %w", syscall.ECONNRESET), want: true},
+		{policy: DefaultRetryPolicy, err: io.EOF, want: true},
+		{policy: DefaultRetryPolicy, err: thrift.NewTransportException(thrift.TIMED_OUT, "i/o timeout"), want: true},
+		{policy: DefaultRetryPolicy, err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, want: true},
+		{policy: DefaultRetryPolicy, err: errors.New("failed to get session after 1 retries"), want: true},
+		{policy: DefaultRetryPolicy, err: context.Canceled, want: false},
+		{policy: DefaultRetryPolicy, err: fmt.Errorf("execute: %w", context.DeadlineExceeded), want: false},
+		{policy: DefaultRetryPolicy, code: nebula.ErrorCode_E_SESSION_INVALID, want: true},
+		{policy: DefaultRetryPolicy, code: nebula.ErrorCode(nebulattypes.ErrorCode_E_LEADER_CHANGED), want: true},
+		{policy: DefaultRetryPolicy, code: nebula.ErrorCode_E_EXECUTION_ERROR, want: false},
+		{policy: DefaultRetryPolicy, code: nebula.ErrorCode_E_SEMANTIC_ERROR, want: false},
+		{policy: DefaultRetryPolicy, code: nebula.ErrorCode_E_SYNTAX_ERROR, want: false},
+		{policy: RetryPolicy{RetryableCodes: []nebula.ErrorCode{nebula.ErrorCode_E_SYNTAX_ERROR}}, code: nebula.ErrorCode_E_SYNTAX_ERROR, want: true},
+		{policy: RetryPolicy{RetryableCodes: []nebula.ErrorCode{nebula.ErrorCode_E_SYNTAX_ERROR}}, code: nebula.ErrorCode_E_EXECUTION_ERROR, want: false},
+		{policy: RetryPolicy{Classifier: func(err error, res *nebula.ResultSet) bool { return true }}, code: nebula.ErrorCode_E_SYNTAX_ERROR, want: true},
+		{policy: RetryPolicy{Classifier: func(err error, res *nebula.ResultSet) bool { return true }}, err: context.Canceled, want: false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			var res *nebula.ResultSet
+			if tt.err == nil {
+				res = genResultSet(t, tt.code)
+			}
+			assert.Equal(t, tt.want, tt.policy.shouldRetry(tt.err, res))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		policy   RetryPolicy
+		retry    int
+		min, max time.Duration
+	}{
+		{policy: RetryPolicy{InitialBackoff: 100 * time.Millisecond}, retry: 1, min: 100 * time.Millisecond, max: 100 * time.Millisecond},
+		{policy: RetryPolicy{InitialBackoff: 100 * time.Millisecond}, retry: 3, min: 400 * time.Millisecond, max: 400 * time.Millisecond},
+		{policy: RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 250 * time.Millisecond}, retry: 3, min: 250 * time.Millisecond, max: 250 * time.Millisecond},
+		{policy: RetryPolicy{InitialBackoff: 100 * time.Millisecond, Jitter: 0.2}, retry: 2, min: 160 * time.Millisecond, max: 240 * time.Millisecond},
+		{policy: RetryPolicy{}, retry: 1, min: 0, max: 0},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			for j := 0; j < 10; j++ {
+				wait := tt.policy.backoff(tt.retry)
+				assert.GreaterOrEqual(t, wait, tt.min)
+				assert.LessOrEqual(t, wait, tt.max)
+			}
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		db   *DB
+		kind OperationKind
+		want bool
+	}{
+		{db: &DB{Statement: statement.New().Go().From("player100").Over("follow").Yield("dst(edge)")}, kind: OperationQuery, want: true},
+		{db: &DB{Statement: statement.New().Go().From("player100").Over("follow").Yield("dst(edge) AS dst").Pipe().DeleteVertex("$-.dst")}, kind: OperationDelete, want: false},
+		{db: &DB{Statement: statement.New().InsertVertex(retryPlayer{VID: "player100"})}, kind: OperationInsert, want: false},
+		{db: &DB{Statement: statement.New().InsertVertex(retryPlayer{VID: "player100"}, true)}, kind: OperationInsert, want: false},
+		{db: &DB{Statement: statement.New().UpsertVertex("player100", retryPlayer{Name: "Tim"})}, kind: OperationUpdate, want: false},
+		{db: &DB{Statement: statement.New().InsertVertex(retryPlayer{VID: "player100"}), idempotent: true}, kind: OperationInsert, want: true},
+		{db: &DB{Statement: statement.New().Raw(`GO FROM "player100" OVER follow YIELD dst(edge) AS dst | ORDER BY $-.dst`)}, kind: OperationQuery, want: true},
+		{db: &DB{Statement: statement.New().Raw(`$a = LOOKUP ON player YIELD id(vertex) AS vid; FETCH PROP ON player $a.vid YIELD properties(vertex)`)}, kind: OperationQuery, want: true},
+		{db: &DB{Statement: statement.New().Raw(`match (v:player) return v`)}, kind: OperationQuery, want: true},
+		{db: &DB{Statement: statement.New().Raw(`INSERT VERTEX player(name) VALUES "player100":("Tim")`)}, kind: OperationInsert, want: false},
+		{db: &DB{Statement: statement.New().Raw(`DELETE VERTEX "player100"`)}, kind: OperationDelete, want: false},
+		{db: &DB{Statement: statement.New().Raw(`UPSERT VERTEX ON player "player100" SET name = "Tim"`)}, kind: OperationUpdate, want: false},
+		{db: &DB{Statement: statement.New().Raw(`CREATE TAG IF NOT EXISTS player(name string)`)}, kind: OperationMigrate, want: false},
+		{db: &DB{Statement: statement.New().Raw(`USE basketball; DELETE EDGE follow "player100"->"player101"`)}, kind: OperationDelete, want: false},
+		{db: &DB{Statement: statement.New().Raw(`USE basketball`)}, kind: OperationQuery, want: false},
+		{db: &DB{Statement: statement.New().Raw(`DELETE VERTEX "player100"`), idempotent: true}, kind: OperationDelete, want: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			assert.Equal(t, tt.kind, tt.db.Operation())
+			assert.Equal(t, tt.want, tt.db.retryable())
+		})
+	}
+}
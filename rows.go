@@ -0,0 +1,233 @@
+package norm
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/haysons/norm/internal/utils"
+	"github.com/haysons/norm/resolver"
+	"github.com/haysons/norm/statement"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+// RowsOption is the option of DB.Rows
+type RowsOption func(opts *rowsOptions)
+
+type rowsOptions struct {
+	pageSize int
+	keyCol   string
+	keyCond  string
+}
+
+// PageByOffset reissues the statement with a shifting LIMIT offset, size rows at a time, the statement should be
+// ordered for the pages to be stable, see statement.Statement.Page
+func PageByOffset(size int) RowsOption {
+	return func(opts *rowsOptions) {
+		opts.pageSize = size
+		opts.keyCol, opts.keyCond = "", ""
+	}
+}
+
+// PageByKey reissues the statement ordered by the yielded col, size rows at a time, and adds cond to its WHERE clause
+// with the value of col in the last row of the previous page, see statement.Statement.KeysetPage. Unlike the offset,
+// the server does not skip the previous rows again for every page, and the rows are not missed or repeated when the
+// data is changed during the iteration, so the col should be unique, such as the vertex id.
+//
+//	rows, err := db.Lookup("player").Yield("id(vertex) AS vid, player.name AS name").
+//		Rows(norm.PageByKey(1000, "vid", "id(vertex) > ?"))
+func PageByKey(size int, col string, cond string) RowsOption {
+	return func(opts *rowsOptions) {
+		opts.pageSize = size
+		opts.keyCol, opts.keyCond = col, cond
+	}
+}
+
+// Rows is the cursor over the result of a statement, the rows are scanned one by one, so the destination slice of all
+// the rows is never built. With PageByOffset or PageByKey, only one page of rows is held in memory at a time. Rows is
+// not concurrency safe.
+//
+//	rows, err := db.Lookup("player").Yield("id(vertex) AS vid, player.name AS name").Rows(norm.PageByOffset(1000))
+//	if err != nil {
+//		return err
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//		player := new(Player)
+//		if err := rows.Scan(player); err != nil {
+//			return err
+//		}
+//	}
+//	return rows.Err()
+type Rows struct {
+	db       *DB
+	stmt     *statement.Statement
+	opts     *rowsOptions
+	res      *nebula.ResultSet
+	index    int
+	offset   int
+	after    any
+	lastPage bool
+	closed   bool
+	err      error
+	rv       *resolver.Resolver
+}
+
+// Rows executes the statement and returns the cursor over its result, the statement is reissued for each page if a
+// page option is given. The raw statements cannot be paged as their clauses cannot be changed.
+func (db *DB) Rows(opts ...RowsOption) (*Rows, error) {
+	tx := db.getInstance()
+	rowsOpts := new(rowsOptions)
+	for _, opt := range opts {
+		opt(rowsOpts)
+	}
+	if rowsOpts.pageSize < 0 || (rowsOpts.pageSize > 0 && tx.Statement.IsRaw()) {
+		return nil, fmt.Errorf("norm: %w, only the positive page size of the statements not written by Raw is allowed", ErrInvalidValue)
+	}
+	rows := &Rows{
+		db:   tx,
+		stmt: tx.Statement,
+		opts: rowsOpts,
+		rv:   resolver.NewResolver(),
+	}
+	if err := rows.fetch(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Next prepares the next row to be scanned, it returns false when there are no more rows or an error occurred, which
+// can be checked by Err. The next page is fetched when the rows of the current page are exhausted.
+func (r *Rows) Next() bool {
+	if r.closed || r.err != nil {
+		return false
+	}
+	r.index++
+	if r.index < r.res.GetRowSize() {
+		return true
+	}
+	if r.opts.pageSize == 0 || r.lastPage {
+		return false
+	}
+	if r.err = r.fetch(); r.err != nil {
+		return false
+	}
+	r.index++
+	return r.index < r.res.GetRowSize()
+}
+
+// Scan assigns the current row to dest, which is a pointer to a struct or a map[string]any
+func (r *Rows) Scan(dest any) error {
+	if r.closed {
+		return fmt.Errorf("norm: %w, rows are closed", ErrInvalidValue)
+	}
+	record, err := r.res.GetRowValuesByIndex(r.index)
+	if err != nil {
+		return err
+	}
+	switch v := dest.(type) {
+	case *map[string]any:
+		if *v == nil {
+			*v = make(map[string]any)
+		}
+		return scanIntoMap(record, r.res.GetColNames(), *v)
+	case map[string]any:
+		return scanIntoMap(record, r.res.GetColNames(), v)
+	}
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr {
+		return fmt.Errorf("norm: %w, scan dest should be pointer to struct or map", ErrInvalidValue)
+	}
+	destValue = utils.PtrValue(destValue)
+	if destValue.Kind() != reflect.Struct {
+		return fmt.Errorf("norm: %w, scan dest should be pointer to struct or map", ErrInvalidValue)
+	}
+	return r.rv.ScanRecord(record, r.res.GetColNames(), destValue)
+}
+
+// ColNames returns the names of the columns of the result
+func (r *Rows) ColNames() []string {
+	return r.res.GetColNames()
+}
+
+// Err returns the error occurred during the iteration
+func (r *Rows) Err() error {
+	return r.err
+}
+
+// Close releases the result held by the cursor, Next returns false after it is closed
+func (r *Rows) Close() error {
+	r.closed = true
+	r.res = nil
+	return nil
+}
+
+// fetch executes the statement, or the statement of the next page, and resets the cursor before the first row
+func (r *Rows) fetch() error {
+	tx := r.db
+	if r.opts.pageSize > 0 {
+		switch {
+		case r.opts.keyCol == "":
+			tx = r.db.withStatement(r.stmt.Page(r.opts.pageSize, r.offset))
+		case r.after == nil:
+			tx = r.db.withStatement(r.stmt.KeysetPage(r.opts.pageSize, r.opts.keyCol, r.opts.keyCond))
+		default:
+			tx = r.db.withStatement(r.stmt.KeysetPage(r.opts.pageSize, r.opts.keyCol, r.opts.keyCond, r.after))
+		}
+	}
+	res, err := tx.succeededResult()
+	if err != nil {
+		return err
+	}
+	r.res = res
+	r.index = -1
+	if r.opts.pageSize == 0 {
+		return nil
+	}
+	rowSize := res.GetRowSize()
+	r.offset += rowSize
+	r.lastPage = rowSize < r.opts.pageSize
+	if r.opts.keyCol != "" && rowSize > 0 {
+		record, err := res.GetRowValuesByIndex(rowSize - 1)
+		if err != nil {
+			return err
+		}
+		value, err := record.GetValueByColName(r.opts.keyCol)
+		if err != nil {
+			return err
+		}
+		if r.after, err = resolver.GetValueIface(value); err != nil {
+			return err
+		}
+		if r.after == nil {
+			return fmt.Errorf("norm: %w, the key col %s of the last row is null", ErrInvalidValue, r.opts.keyCol)
+		}
+	}
+	return nil
+}
+
+// Iterator is the generic version of Rows, it is returned by ExecInterface.Iter
+type Iterator[T any] struct {
+	rows *Rows
+}
+
+// Next prepares the next row to be scanned, see Rows.Next
+func (it *Iterator[T]) Next() bool {
+	return it.rows.Next()
+}
+
+// Scan returns the current row, T is a struct, a pointer to a struct or map[string]any
+func (it *Iterator[T]) Scan() (T, error) {
+	var v T
+	err := it.rows.Scan(&v)
+	return v, err
+}
+
+// Err returns the error occurred during the iteration
+func (it *Iterator[T]) Err() error {
+	return it.rows.Err()
+}
+
+// Close releases the result held by the iterator
+func (it *Iterator[T]) Close() error {
+	return it.rows.Close()
+}
@@ -0,0 +1,163 @@
+package norm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+)
+
+func TestRows(t *testing.T) {
+	lookup := "LOOKUP ON player YIELD id(vertex) AS vid, player.name AS name"
+	keyset := "LOOKUP ON player%s YIELD id(vertex) AS vid, player.name AS name | ORDER BY $-.vid | LIMIT 2;"
+	tests := []struct {
+		opts       []RowsOption
+		pages      map[string][][]any
+		wantNGQL   []string
+		want       []string
+		wantOffset int
+		wantErr    error
+		wantIter   error
+	}{
+		{
+			pages: map[string][][]any{
+				lookup + ";": {{"p1", "a"}, {"p2", "b"}, {"p3", "c"}},
+			},
+			wantNGQL: []string{lookup + ";"},
+			want:     []string{"p1", "p2", "p3"},
+		},
+		{
+			opts: []RowsOption{PageByOffset(2)},
+			pages: map[string][][]any{
+				lookup + " | LIMIT 2;":    {{"p1", "a"}, {"p2", "b"}},
+				lookup + " | LIMIT 2, 2;": {{"p3", "c"}, {"p4", "d"}},
+				lookup + " | LIMIT 4, 2;": {{"p5", "e"}},
+			},
+			wantNGQL:   []string{lookup + " | LIMIT 2;", lookup + " | LIMIT 2, 2;", lookup + " | LIMIT 4, 2;"},
+			want:       []string{"p1", "p2", "p3", "p4", "p5"},
+			wantOffset: 5,
+		},
+		{
+			opts: []RowsOption{PageByOffset(2)},
+			pages: map[string][][]any{
+				lookup + " | LIMIT 2;":    {{"p1", "a"}, {"p2", "b"}},
+				lookup + " | LIMIT 2, 2;": {},
+			},
+			wantNGQL:   []string{lookup + " | LIMIT 2;", lookup + " | LIMIT 2, 2;"},
+			want:       []string{"p1", "p2"},
+			wantOffset: 2,
+		},
+		{
+			opts: []RowsOption{PageByKey(2, "vid", "id(vertex) > ?")},
+			pages: map[string][][]any{
+				fmt.Sprintf(keyset, ""):                         {{"p1", "a"}, {"p2", "b"}},
+				fmt.Sprintf(keyset, ` WHERE id(vertex) > "p2"`): {{"p3", "c"}},
+			},
+			wantNGQL:   []string{fmt.Sprintf(keyset, ""), fmt.Sprintf(keyset, ` WHERE id(vertex) > "p2"`)},
+			want:       []string{"p1", "p2", "p3"},
+			wantOffset: 3,
+		},
+		{
+			opts: []RowsOption{PageByKey(2, "vid", "id(vertex) > ?")},
+			pages: map[string][][]any{
+				fmt.Sprintf(keyset, ""):                         {{"p1", "a"}, {"p2", "b"}},
+				fmt.Sprintf(keyset, ` WHERE id(vertex) > "p2"`): {{"p3", "c"}, {"p4", "d"}},
+				fmt.Sprintf(keyset, ` WHERE id(vertex) > "p4"`): {},
+			},
+			wantNGQL: []string{
+				fmt.Sprintf(keyset, ""),
+				fmt.Sprintf(keyset, ` WHERE id(vertex) > "p2"`),
+				fmt.Sprintf(keyset, ` WHERE id(vertex) > "p4"`),
+			},
+			want:       []string{"p1", "p2", "p3", "p4"},
+			wantOffset: 4,
+		},
+		{
+			opts: []RowsOption{PageByKey(2, "vid", "id(vertex) > ?")},
+			pages: map[string][][]any{
+				fmt.Sprintf(keyset, ""): {{"p1", "a"}, {nil, "b"}},
+			},
+			wantNGQL: []string{fmt.Sprintf(keyset, "")},
+			wantErr:  ErrInvalidValue,
+		},
+		{
+			opts: []RowsOption{PageByKey(2, "vid", "id(vertex) > ?")},
+			pages: map[string][][]any{
+				fmt.Sprintf(keyset, ""):                         {{"p1", "a"}, {"p2", "b"}},
+				fmt.Sprintf(keyset, ` WHERE id(vertex) > "p2"`): {{"p3", "c"}, {nil, "d"}},
+			},
+			wantNGQL:   []string{fmt.Sprintf(keyset, ""), fmt.Sprintf(keyset, ` WHERE id(vertex) > "p2"`)},
+			want:       []string{"p1", "p2"},
+			wantOffset: 4,
+			wantIter:   ErrInvalidValue,
+		},
+		{
+			opts:    []RowsOption{PageByOffset(-1)},
+			wantErr: ErrInvalidValue,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			var gotNGQL []string
+			db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+				gotNGQL = append(gotNGQL, nGQL)
+				rows, ok := tt.pages[nGQL]
+				if !ok {
+					t.Fatalf("unexpected statement %s", nGQL)
+				}
+				return genDataSet(t, []string{"vid", "name"}, rows...)
+			})
+			rows, err := db.Lookup("player").Yield("id(vertex) AS vid, player.name AS name").Rows(tt.opts...)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				assert.Equal(t, tt.wantNGQL, gotNGQL)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			var got []string
+			for rows.Next() {
+				row := make(map[string]any)
+				if assert.NoError(t, rows.Scan(row)) {
+					got = append(got, row["vid"].(string))
+				}
+			}
+			if tt.wantIter != nil {
+				assert.True(t, errors.Is(rows.Err(), tt.wantIter))
+			} else {
+				assert.NoError(t, rows.Err())
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNGQL, gotNGQL)
+			assert.Equal(t, tt.wantOffset, rows.offset)
+			assert.False(t, rows.Next())
+			assert.Equal(t, tt.wantNGQL, gotNGQL)
+		})
+	}
+}
+
+func TestRowsRaw(t *testing.T) {
+	db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+		t.Fatalf("unexpected statement %s", nGQL)
+		return nil
+	})
+	_, err := db.Raw("LOOKUP ON player YIELD id(vertex) AS vid").Rows(PageByOffset(2))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestRowsClose(t *testing.T) {
+	db := newTestDB(t, func(nGQL string) *nebula.ResultSet {
+		return genDataSet(t, []string{"vid"}, []any{"p1"}, []any{"p2"})
+	})
+	rows, err := db.Lookup("player").Yield("id(vertex) AS vid").Rows()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, rows.Next())
+	assert.NoError(t, rows.Close())
+	assert.False(t, rows.Next())
+	assert.True(t, errors.Is(rows.Scan(new(map[string]any)), ErrInvalidValue))
+}
@@ -0,0 +1,54 @@
+package statement
+
+import (
+	"strings"
+
+	"github.com/haysons/norm/clause"
+)
+
+// FindPath generate find path clause, the path type can be clause.PathTypeShortest, clause.PathTypeSingleShortest,
+// clause.PathTypeAll or clause.PathTypeNoLoop
+//
+// FIND SHORTEST PATH FROM "player102" TO "team204" OVER * YIELD path AS p
+// stmt.FindPath(clause.PathTypeShortest).From("player102").To("team204").Over("*").Yield("path AS p")
+//
+// FIND ALL PATH WITH PROP FROM "player100" TO "team204" OVER * WHERE follow.degree is EMPTY or follow.degree >=0 UPTO 3 STEPS YIELD path AS p
+// stmt.FindPath(clause.PathTypeAll, true).From("player100").To("team204").Over("*").
+// Where("follow.degree is EMPTY or follow.degree >=0").Upto(3).Yield("path AS p")
+func (stmt *Statement) FindPath(pathType string, withProp ...bool) *Statement {
+	var withPropOpt bool
+	if len(withProp) > 0 {
+		withPropOpt = withProp[0]
+	}
+	stmt.AddClause(&clause.FindPath{
+		PathType: strings.ToUpper(pathType),
+		WithProp: withPropOpt,
+	})
+	stmt.SetPartType(PartTypeFindPath)
+	return stmt
+}
+
+// To generate to clause, the usage of vid is the same as From
+//
+// TO "team204"
+// stmt.To("team204")
+//
+// TO $-.dst
+// stmt.To(clause.Expr{Str: "$-.dst"})
+func (stmt *Statement) To(vid any) *Statement {
+	stmt.AddClause(&clause.To{
+		VID: vid,
+	})
+	return stmt
+}
+
+// Upto generate upto clause
+//
+// UPTO 3 STEPS
+// stmt.Upto(3)
+func (stmt *Statement) Upto(steps int) *Statement {
+	stmt.AddClause(&clause.Upto{
+		Steps: steps,
+	})
+	return stmt
+}
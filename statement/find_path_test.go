@@ -0,0 +1,64 @@
+package statement
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPath(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().FindPath(clause.PathTypeShortest).From("player102").To("team204").Over("*").Yield("path AS p")
+			},
+			want: `FIND SHORTEST PATH FROM "player102" TO "team204" OVER * YIELD path AS p;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().FindPath("all", true).From("player100").To("team204").Over("*").
+					Where("follow.degree is EMPTY or follow.degree >= ?", 0).Upto(3).Yield("path AS p")
+			},
+			want: `FIND ALL PATH WITH PROP FROM "player100" TO "team204" OVER * WHERE (follow.degree is EMPTY or follow.degree >= 0) UPTO 3 STEPS YIELD path AS p;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().FindPath(clause.PathTypeNoLoop).From([]string{"player100", "player101"}).To("team204").Over("follow", "serve", clause.OverDirectBidirect).
+					Upto(2).Yield("path AS p").OrderBy("$-.p").Limit(10)
+			},
+			want: `FIND NOLOOP PATH FROM "player100", "player101" TO "team204" OVER follow, serve BIDIRECT UPTO 2 STEPS YIELD path AS p | ORDER BY $-.p | LIMIT 10;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("follow").Yield("dst(edge) AS dst").Pipe().
+					FindPath(clause.PathTypeSingleShortest).From("player100").To(clause.Expr{Str: "$-.dst"}).Over("*").Yield("path AS p")
+			},
+			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS dst | FIND SINGLE SHORTEST PATH FROM "player100" TO $-.dst OVER * YIELD path AS p;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().FindPath("longest").From("player100").To("team204").Over("*").Yield("path AS p")
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			s := tt.stmt()
+			ngql, err := s.NGQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, ngql)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package statement
+
+import (
+	"fmt"
+
+	"github.com/haysons/norm/clause"
+)
+
+// Match generate openCypher match clause, a pattern can be a string, a clause.Expr or a *clause.Pattern built
+// from vertex and edge structs, multiple patterns are separated by commas
+//
+// MATCH (v:player) WHERE v.player.age > 30 RETURN v
+// stmt.Match("(v:player)").Where("v.player.age > ?", 30).Return("v")
+//
+// MATCH (v:player{name: "Tim Duncan"})-[e:follow]->(v2:player) RETURN v2
+// stmt.Match(clause.Node("v", &Player{Name: "Tim Duncan"}).Out("e", Follow{}).Node("v2", Player{})).Return("v2")
+//
+// MATCH (v:player) WITH v ORDER BY v.player.age DESC LIMIT 3 MATCH (v)-[e:serve]->(t:team) RETURN v, t
+// stmt.Match(clause.Node("v", Player{})).With("v").OrderBy("v.player.age DESC").Limit(3).
+// Match(clause.Node("v").Out("e", Serve{}).Node("t", Team{})).Return("v, t")
+func (stmt *Statement) Match(patterns ...any) *Statement {
+	exprList, err := patternExprList(patterns)
+	if err != nil {
+		stmt.err = err
+		return stmt
+	}
+	stmt.cypherPart()
+	stmt.AddClause(&clause.Match{
+		Patterns: exprList,
+	})
+	return stmt
+}
+
+// OptionalMatch generate openCypher optional match clause
+//
+// MATCH (m)-[]->(n) WHERE id(m) == "player100" OPTIONAL MATCH (n)-[]->(l) RETURN id(m), id(n), id(l)
+// stmt.Match("(m)-[]->(n)").Where("id(m) == ?", "player100").OptionalMatch("(n)-[]->(l)").Return("id(m), id(n), id(l)")
+func (stmt *Statement) OptionalMatch(patterns ...any) *Statement {
+	exprList, err := patternExprList(patterns)
+	if err != nil {
+		stmt.err = err
+		return stmt
+	}
+	stmt.cypherPart()
+	stmt.AddClause(&clause.OptionalMatch{
+		Patterns: exprList,
+	})
+	return stmt
+}
+
+// With generate openCypher with clause
+//
+// MATCH (v:player) WITH v.player.age AS age WHERE age > 40 RETURN age
+// stmt.Match("(v:player)").With("v.player.age AS age").Where("age > ?", 40).Return("age")
+//
+// WITH DISTINCT v
+// stmt.With("v", true)
+func (stmt *Statement) With(expr string, distinct ...bool) *Statement {
+	var distinctOpt bool
+	if len(distinct) > 0 {
+		distinctOpt = distinct[0]
+	}
+	stmt.cypherPart()
+	stmt.AddClause(&clause.With{
+		Distinct: distinctOpt,
+		ExprList: []string{expr},
+	})
+	return stmt
+}
+
+// Unwind generate openCypher unwind clause
+//
+// UNWIND [1, 2, 3] AS n RETURN n
+// stmt.Unwind("[1, 2, 3]", "n").Return("n")
+func (stmt *Statement) Unwind(expr string, alias string) *Statement {
+	stmt.cypherPart()
+	stmt.AddClause(&clause.Unwind{
+		Expr:  expr,
+		Alias: alias,
+	})
+	return stmt
+}
+
+// Return generate openCypher return clause
+//
+// MATCH (v:player) RETURN v.player.name AS name, v.player.age AS age
+// stmt.Match("(v:player)").Return("v.player.name AS name, v.player.age AS age")
+//
+// RETURN DISTINCT v
+// stmt.Return("v", true)
+func (stmt *Statement) Return(expr string, distinct ...bool) *Statement {
+	var distinctOpt bool
+	if len(distinct) > 0 {
+		distinctOpt = distinct[0]
+	}
+	stmt.cypherPart()
+	stmt.AddClause(&clause.Return{
+		Distinct: distinctOpt,
+		ExprList: []string{expr},
+	})
+	return stmt
+}
+
+// Skip generate openCypher skip clause, it is only valid in openCypher statements
+//
+// MATCH (v:player) RETURN v SKIP 10 LIMIT 5
+// stmt.Match("(v:player)").Return("v").Skip(10).Limit(5)
+func (stmt *Statement) Skip(offset int) *Statement {
+	stmt.AddClause(&clause.Skip{
+		Offset: offset,
+	})
+	return stmt
+}
+
+// cypherPart prepares the part that the next openCypher clause is written into. MATCH, OPTIONAL MATCH, UNWIND, WITH
+// and RETURN each start a new part chained to the previous one, while WHERE, ORDER BY, SKIP and LIMIT are attached
+// to the current part, so that the clauses are built in the order they are called.
+func (stmt *Statement) cypherPart() {
+	part := stmt.LastPart()
+	if part.GetType() == PartTypeMatch && len(part.clauses) > 0 {
+		part = NewPart()
+		part.SetCompType(CompositeTypeChain)
+		stmt.AddPart(part)
+	}
+	stmt.SetPartType(PartTypeMatch)
+}
+
+func patternExprList(patterns []any) ([]clause.Expression, error) {
+	exprList := make([]clause.Expression, 0, len(patterns))
+	for _, pattern := range patterns {
+		switch p := pattern.(type) {
+		case string:
+			exprList = append(exprList, clause.Expr{Str: p})
+		case clause.Pattern:
+			exprList = append(exprList, &p)
+		case clause.Expression:
+			exprList = append(exprList, p)
+		default:
+			return nil, fmt.Errorf("norm: %w, match pattern must be a string, clause.Expr or *clause.Pattern", clause.ErrInvalidClauseParams)
+		}
+	}
+	return exprList, nil
+}
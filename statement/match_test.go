@@ -0,0 +1,80 @@
+package statement
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/haysons/norm/clause"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().Match("(v:player)").Where("v.player.age > ?", 30).Return("v")
+			},
+			want: `MATCH (v:player) WHERE v.player.age > 30 RETURN v;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match(clause.Node("v", &vm1{Name: "Tim Duncan"}).Out("e", em1{}).Node("v2", "player")).Return("v2.player.name AS name")
+			},
+			want: `MATCH (v:player{name: "Tim Duncan"})-[e:follow]->(v2:player) RETURN v2.player.name AS name;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match("(m)-[]->(n)").Where("id(m) == ?", "player100").OptionalMatch("(n)-[]->(l)").Return("id(m), id(n), id(l)")
+			},
+			want: `MATCH (m)-[]->(n) WHERE id(m) == "player100" OPTIONAL MATCH (n)-[]->(l) RETURN id(m), id(n), id(l);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match(clause.Node("v", vm1{})).With("v").OrderBy("v.player.age DESC").Limit(3).
+					Match(clause.Node("v").Out("e", "serve").Node("t", "team")).Return("v, t")
+			},
+			want: `MATCH (v:player) WITH v ORDER BY v.player.age DESC LIMIT 3 MATCH (v)-[e:serve]->(t:team) RETURN v, t;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match("(v:player)").With("v.player.age AS age").Where("age > ?", 40).Return("age", true).OrderBy("age").Limit(5, 10)
+			},
+			want: `MATCH (v:player) WITH v.player.age AS age WHERE age > 40 RETURN DISTINCT age ORDER BY age SKIP 10 LIMIT 5;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Unwind("[1, 2, 3]", "n").Return("n").Skip(1)
+			},
+			want: `UNWIND [1, 2, 3] AS n RETURN n SKIP 1;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match("(v:player)", "(t:team)").Return("v, t").Limit(1).Pipe().Yield("$-.v")
+			},
+			want: `MATCH (v:player), (t:team) RETURN v, t LIMIT 1 | YIELD $-.v;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match(1).Return("v")
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			s := tt.stmt()
+			ngql, err := s.NGQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, ngql)
+			}
+		})
+	}
+}
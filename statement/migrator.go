@@ -474,3 +474,58 @@ func (stmt *Statement) DropEdgeIndex(indexName string, ifExists ...bool) *Statem
 	stmt.SetPartType(PartTypeDropIndex)
 	return stmt
 }
+
+// CreateSpace creates a graph space with the given vid type, which is FIXED_STRING(<N>) or INT64.
+//
+// stmt.CreateSpace("test", "FIXED_STRING(32)", clause.WithPartitionNum(15), clause.WithReplicaFactor(1), clause.WithIfNotExists())
+// CREATE SPACE IF NOT EXISTS test (partition_num = 15, replica_factor = 1, vid_type = FIXED_STRING(32))
+func (stmt *Statement) CreateSpace(spaceName string, vidType string, opts ...clause.SpaceOption) *Statement {
+	createSpace := &clause.CreateSpace{
+		SpaceName: spaceName,
+		VIDType:   vidType,
+	}
+	for _, opt := range opts {
+		opt(createSpace)
+	}
+	stmt.AddClause(createSpace)
+	stmt.SetPartType(PartTypeCreateSpace)
+	return stmt
+}
+
+// CloneSpace creates a graph space with the same schema as the source space, the data is not cloned.
+//
+// stmt.CloneSpace("test2", "test", true)
+// CREATE SPACE IF NOT EXISTS test2 AS test
+func (stmt *Statement) CloneSpace(spaceName string, sourceSpaceName string, ifNotExists ...bool) *Statement {
+	var notExistsOpt bool
+	if len(ifNotExists) > 0 {
+		notExistsOpt = ifNotExists[0]
+	}
+	stmt.AddClause(&clause.CreateSpace{
+		IfNotExists: notExistsOpt,
+		SpaceName:   spaceName,
+		AsSpace:     sourceSpaceName,
+	})
+	stmt.SetPartType(PartTypeCreateSpace)
+	return stmt
+}
+
+// DropSpace drops a graph space and all the data in it.
+//
+// stmt.DropSpace("test", true)
+// DROP SPACE IF EXISTS test
+func (stmt *Statement) DropSpace(spaceName string, ifExists ...bool) *Statement {
+	if spaceName == "" {
+		return stmt
+	}
+	var existsOpt bool
+	if len(ifExists) > 0 {
+		existsOpt = ifExists[0]
+	}
+	stmt.AddClause(&clause.DropSpace{
+		SpaceName: spaceName,
+		IfExists:  existsOpt,
+	})
+	stmt.SetPartType(PartTypeDropSpace)
+	return stmt
+}
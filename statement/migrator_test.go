@@ -660,3 +660,84 @@ type em5 struct {
 func (e em5) EdgeTypeName() string {
 	return "e1"
 }
+
+func TestCreateSpace(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().CreateSpace("test", "FIXED_STRING(32)")
+			},
+			want: `CREATE SPACE test (vid_type = FIXED_STRING(32));`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().CreateSpace("test", "INT64", clause.WithPartitionNum(15), clause.WithReplicaFactor(1),
+					clause.WithSpaceComment("test space"), clause.WithIfNotExists())
+			},
+			want: `CREATE SPACE IF NOT EXISTS test (partition_num = 15, replica_factor = 1, vid_type = INT64) COMMENT = "test space";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().CloneSpace("test2", "test", true)
+			},
+			want: `CREATE SPACE IF NOT EXISTS test2 AS test;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().CreateSpace("test", "")
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			s := tt.stmt()
+			ngql, err := s.NGQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, ngql)
+			}
+		})
+	}
+}
+
+func TestDropSpace(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().DropSpace("test")
+			},
+			want: `DROP SPACE test;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DropSpace("test", true)
+			},
+			want: `DROP SPACE IF EXISTS test;`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			s := tt.stmt()
+			ngql, err := s.NGQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, ngql)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package statement
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/haysons/norm/clause"
+)
+
+// CountCol is the column of the count yielded by Count
+const CountCol = "total"
+
+// Clone returns a copy of the statement that has not been built yet, the clauses added to the copy do not affect the
+// original statement, so that the same query can be executed repeatedly with different pages
+func (stmt *Statement) Clone() *Statement {
+	clone := New()
+	clone.raw = stmt.raw
+	clone.parameterized = stmt.parameterized
+	clone.err = stmt.err
+	clone.softDeletes = stmt.softDeletes
+	clone.unscoped = stmt.unscoped
+	clone.hardDelete = stmt.hardDelete
+	for _, part := range stmt.parts {
+		clone.AddPart(&Part{
+			typ:          part.typ,
+			setType:      part.setType,
+			compType:     part.compType,
+			clauses:      maps.Clone(part.clauses),
+			clausesBuild: slices.Clone(part.clausesBuild),
+		})
+	}
+	return clone
+}
+
+// IsRaw reports whether the statement is written by Raw, the clauses added to a raw statement are ignored
+func (stmt *Statement) IsRaw() bool {
+	return stmt.raw != nil
+}
+
+// Page returns a copy of the statement that returns the rows in [offset, offset+limit), the statement should be
+// ordered for the pages to be stable
+//
+// LOOKUP ON player YIELD id(vertex) AS vid | ORDER BY $-.vid | LIMIT 20, 10
+// stmt.Lookup("player").Yield("id(vertex) AS vid").OrderBy("$-.vid").Page(10, 20)
+//
+// MATCH (v:player) RETURN v ORDER BY id(v) SKIP 20 LIMIT 10
+// stmt.Match("(v:player)").Return("v").OrderBy("id(v)").Page(10, 20)
+func (stmt *Statement) Page(limit int, offset int) *Statement {
+	return stmt.Clone().Limit(limit, offset)
+}
+
+// KeysetPage returns a copy of the statement that returns at most limit rows ordered by the yielded col. When after is
+// given, cond is added to the WHERE clause of the first part to skip the rows up to the key after, cond usually
+// compares the expression yielded as col with a placeholder. The existing conditions are grouped in parentheses, so
+// that the OR conditions are not weakened. Only GO, LOOKUP and MATCH statements can be paged this way, and they should
+// not be ordered or limited by themselves.
+//
+// LOOKUP ON player WHERE player.age > 30 AND id(vertex) > "player100" YIELD id(vertex) AS vid | ORDER BY $-.vid | LIMIT 10
+// stmt.Lookup("player").Where("player.age > ?", 30).Yield("id(vertex) AS vid").KeysetPage(10, "vid", "id(vertex) > ?", "player100")
+func (stmt *Statement) KeysetPage(limit int, col string, cond string, after ...any) *Statement {
+	clone := stmt.Clone()
+	if len(clone.parts) == 0 {
+		return clone
+	}
+	first := clone.parts[0]
+	switch first.typ {
+	case PartTypeGo, PartTypeLookup, PartTypeMatch:
+	default:
+		clone.err = fmt.Errorf("norm: %w, keyset paging is only supported by GO, LOOKUP and MATCH", clause.ErrInvalidClauseParams)
+		return clone
+	}
+	if len(after) > 0 {
+		keyset := clone.buildCondition(clause.OperatorAnd, cond, after...)
+		where := clause.Where{Conditions: []clause.Condition{keyset}}
+		if c, ok := first.clauses[clause.WhereName]; ok {
+			if exist, ok := c.Expression.(clause.Where); ok && len(exist.Conditions) > 0 {
+				where.Conditions = []clause.Condition{{Operator: clause.OperatorAnd, Group: exist.Conditions}, keyset}
+			}
+		}
+		first.clauses[clause.WhereName] = clause.Clause{Name: clause.WhereName, Expression: where}
+	}
+	orderExpr := col
+	if clone.LastPart().GetType() != PartTypeMatch {
+		orderExpr = "$-." + col
+	}
+	return clone.OrderBy(orderExpr).Limit(limit)
+}
+
+// Count returns a copy of the statement that counts the rows returned by it, the count is yielded as total
+//
+// LOOKUP ON player WHERE player.age > 30 YIELD id(vertex) AS vid | YIELD COUNT(*) AS total
+// stmt.Lookup("player").Where("player.age > ?", 30).Yield("id(vertex) AS vid").Count()
+func (stmt *Statement) Count() *Statement {
+	return stmt.Clone().Pipe().Yield("COUNT(*) AS " + CountCol)
+}
@@ -0,0 +1,93 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Yield("id(vertex) AS vid").OrderBy("$-.vid").Page(10, 20)
+			},
+			want: `LOOKUP ON player YIELD id(vertex) AS vid | ORDER BY $-.vid | LIMIT 20, 10;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match("(v:player)").Return("v").OrderBy("id(v)").Page(10, 20)
+			},
+			want: `MATCH (v:player) RETURN v ORDER BY id(v) SKIP 20 LIMIT 10;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Yield("id(vertex) AS vid").KeysetPage(10, "vid", "id(vertex) > ?")
+			},
+			want: `LOOKUP ON player YIELD id(vertex) AS vid | ORDER BY $-.vid | LIMIT 10;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.age > ?", 30).Or("player.age < ?", 20).
+					Yield("id(vertex) AS vid").KeysetPage(10, "vid", "id(vertex) > ?", "player100")
+			},
+			want: `LOOKUP ON player WHERE (player.age > 30 OR player.age < 20) AND id(vertex) > "player100" YIELD id(vertex) AS vid | ORDER BY $-.vid | LIMIT 10;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("follow").Yield("dst(edge) AS dst").
+					KeysetPage(5, "dst", "dst(edge) > ?", "player101")
+			},
+			want: `GO FROM "player100" OVER follow WHERE dst(edge) > "player101" YIELD dst(edge) AS dst | ORDER BY $-.dst | LIMIT 5;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Match("(v:player)").Return("id(v) AS vid").KeysetPage(10, "vid", "id(v) > ?", "player100")
+			},
+			want: `MATCH (v:player) WHERE id(v) > "player100" RETURN id(v) AS vid ORDER BY vid LIMIT 10;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.age > ?", 30).Yield("id(vertex) AS vid").Count()
+			},
+			want: `LOOKUP ON player WHERE player.age > 30 YIELD id(vertex) AS vid | YIELD COUNT(*) AS total;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("follow").Yield("dst(edge) AS dst").OrderBy("$-.dst").Count()
+			},
+			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS dst | ORDER BY $-.dst | YIELD COUNT(*) AS total;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("player", "player100").Yield("properties(vertex)").KeysetPage(10, "vid", "id(vertex) > ?", "player100")
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		got, err := tt.stmt().NGQL()
+		if tt.wantErr {
+			assert.Error(t, err, "case %d", i)
+			continue
+		}
+		if assert.NoError(t, err, "case %d", i) {
+			assert.Equal(t, tt.want, got, "case %d", i)
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	stmt := New().Lookup("player").Where("player.age > ?", 30).Yield("id(vertex) AS vid")
+	page := stmt.KeysetPage(10, "vid", "id(vertex) > ?", "player100")
+	_, err := page.NGQL()
+	assert.NoError(t, err)
+	got, err := stmt.NGQL()
+	if assert.NoError(t, err) {
+		assert.Equal(t, `LOOKUP ON player WHERE player.age > 30 YIELD id(vertex) AS vid;`, got)
+	}
+}
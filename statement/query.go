@@ -6,13 +6,23 @@ import (
 	"github.com/haysons/norm/clause"
 )
 
-// Raw execute any statement
-func (stmt *Statement) Raw(raw string) *Statement {
-	stmt.nGQL.WriteString(raw)
-	stmt.built = true
+// Raw execute any statement, the arguments replace the placeholders in the statement in the same way as Where
+//
+// LOOKUP ON player WHERE player.name == "Tim Duncan" YIELD id(vertex)
+// stmt.Raw("LOOKUP ON player WHERE player.name == ? YIELD id(vertex)", "Tim Duncan")
+func (stmt *Statement) Raw(raw string, args ...any) *Statement {
+	stmt.raw = &clause.Expr{Str: raw, Vars: args}
 	return stmt
 }
 
+// RawNGQL returns the nGQL written by Raw before the placeholders are replaced, it is empty if the statement is not raw
+func (stmt *Statement) RawNGQL() string {
+	if stmt.raw == nil {
+		return ""
+	}
+	return stmt.raw.Str
+}
+
 // Go generate go clause
 //
 // GO
@@ -265,6 +275,13 @@ func (stmt *Statement) Yield(expr string, distinct ...bool) *Statement {
 // ORDER BY $-.age ASC, $-.name DESC
 // stmt.OrderBy("$-.age ASC, $-.name DESC")
 func (stmt *Statement) OrderBy(expr string) *Statement {
+	// in openCypher statements, ORDER BY is part of the RETURN or WITH clause
+	if stmt.LastPart().GetType() == PartTypeMatch {
+		stmt.AddClause(&clause.Order{
+			Expr: expr,
+		})
+		return stmt
+	}
 	stmt.Pipe()
 	stmt.AddClause(&clause.Order{
 		Expr: expr,
@@ -280,12 +297,26 @@ func (stmt *Statement) OrderBy(expr string) *Statement {
 //
 // LIMIT 3, 5
 // stmt.Limit(5, 3)
+//
+// in openCypher statements the limit is appended to the RETURN or WITH clause
+//
+// MATCH (v:player) RETURN v SKIP 3 LIMIT 5
+// stmt.Match(clause.Node("v", "player")).Return("v").Limit(5, 3)
 func (stmt *Statement) Limit(limit int, offset ...int) *Statement {
-	stmt.Pipe()
 	var offsetOpt int
 	if len(offset) > 0 {
 		offsetOpt = offset[0]
 	}
+	if stmt.LastPart().GetType() == PartTypeMatch {
+		if offsetOpt > 0 {
+			stmt.Skip(offsetOpt)
+		}
+		stmt.AddClause(&clause.Limit{
+			Limit: limit,
+		})
+		return stmt
+	}
+	stmt.Pipe()
 	stmt.AddClause(&clause.Limit{
 		Limit:  limit,
 		Offset: offsetOpt,
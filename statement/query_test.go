@@ -199,6 +199,12 @@ func TestQuery(t *testing.T) {
 			},
 			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS id | GO FROM $-.id OVER serve YIELD properties($$).name AS Team, properties($^).name AS Player`,
 		},
+		{
+			stmt: func() *Statement {
+				return New().Raw(`LOOKUP ON player WHERE player.name == ? YIELD id(vertex)`, "Tim Duncan")
+			},
+			want: `LOOKUP ON player WHERE player.name == "Tim Duncan" YIELD id(vertex)`,
+		},
 		{
 			stmt: func() *Statement {
 				return New().GetSubgraph(1).From("player101").Yield("VERTICES AS nodes, EDGES AS relationships")
@@ -0,0 +1,187 @@
+package statement
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/haysons/norm/resolver"
+)
+
+// ScriptOption is the option of DDLScript
+type ScriptOption func(opts *scriptOptions)
+
+type scriptOptions struct {
+	spaceName    string
+	sleepSeconds int
+}
+
+// WithScriptSpace adds USE <space> at the beginning of the script
+func WithScriptSpace(spaceName string) ScriptOption {
+	return func(opts *scriptOptions) {
+		opts.spaceName = spaceName
+	}
+}
+
+// DefaultScriptSleep is the pause in seconds between the stages of the script, which lasts two heartbeats of the
+// default interval
+const DefaultScriptSleep = 20
+
+// WithScriptSleep sets the :sleep command of nebula-console added between the stages of the script, DefaultScriptSleep
+// is used if it is not set, and 0 removes the command. The created schemas and indexes take effect at the next
+// heartbeat, which is 10 seconds by default, so the indexes should not be created or rebuilt right after the schemas
+// are created. The command is only recognized by nebula-console.
+func WithScriptSleep(seconds int) ScriptOption {
+	return func(opts *scriptOptions) {
+		opts.sleepSeconds = seconds
+	}
+}
+
+// DDLScript renders the complete DDL script of the given vertices and edges without a connection, the structs that
+// implement resolver.EdgeTypeNamer are treated as edges, the others as vertices. The script creates the tags and the
+// edges, then the indexes declared in the structs, and finally rebuilds the indexes, every statement is terminated by
+// a semicolon and written on its own line. The tags and indexes shared by several structs are created only once.
+//
+//	script, err := statement.DDLScript([]any{Player{}, Team{}, Serve{}}, statement.WithScriptSpace("basketball"))
+//	// USE basketball;
+//	// CREATE TAG IF NOT EXISTS player(name string, age int);
+//	// CREATE TAG IF NOT EXISTS team(name string);
+//	// CREATE EDGE IF NOT EXISTS serve(start_year int, end_year int);
+//	// :sleep 20
+//	// CREATE TAG INDEX IF NOT EXISTS idx_player_name ON player(name(20));
+//	// :sleep 20
+//	// REBUILD TAG INDEX idx_player_name;
+func DDLScript(schemas []any, opts ...ScriptOption) (string, error) {
+	scriptOpts := &scriptOptions{sleepSeconds: DefaultScriptSleep}
+	for _, opt := range opts {
+		opt(scriptOpts)
+	}
+	var (
+		tags        []*resolver.VertexTag
+		edges       []*resolver.EdgeSchema
+		tagNames    = make(map[string]bool)
+		edgeNames   = make(map[string]bool)
+		indexNames  = make(map[string]bool)
+		tagIndexes  []*resolver.Index
+		edgeIndexes []*resolver.Index
+	)
+	for _, schema := range schemas {
+		schemaType := reflect.TypeOf(schema)
+		if schemaType == nil {
+			return "", errors.New("norm: render ddl script failed, schema is nil")
+		}
+		elemType := schemaType
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if _, ok := reflect.New(elemType).Interface().(resolver.EdgeTypeNamer); ok {
+			edge, err := resolver.ParseEdge(schemaType)
+			if err != nil {
+				return "", err
+			}
+			if edgeNames[edge.GetTypeName()] {
+				continue
+			}
+			edgeNames[edge.GetTypeName()] = true
+			edges = append(edges, edge)
+			for _, index := range edge.GetIndexes() {
+				if !indexNames[index.Name] {
+					indexNames[index.Name] = true
+					edgeIndexes = append(edgeIndexes, index)
+				}
+			}
+			continue
+		}
+		vertex, err := resolver.ParseVertex(schemaType)
+		if err != nil {
+			return "", err
+		}
+		for _, tag := range vertex.GetTags() {
+			if tagNames[tag.TagName] {
+				continue
+			}
+			tagNames[tag.TagName] = true
+			tags = append(tags, tag)
+			for _, index := range tag.GetIndexes() {
+				if !indexNames[index.Name] {
+					indexNames[index.Name] = true
+					tagIndexes = append(tagIndexes, index)
+				}
+			}
+		}
+	}
+
+	script := &scriptBuilder{}
+	if scriptOpts.spaceName != "" {
+		script.writeLine("USE " + scriptOpts.spaceName)
+	}
+	for _, tag := range tags {
+		if err := script.writeStatement(New().CreateVertexTags(tag, true)); err != nil {
+			return "", err
+		}
+	}
+	for _, edge := range edges {
+		if err := script.writeStatement(New().CreateEdge(edge, true)); err != nil {
+			return "", err
+		}
+	}
+	if len(tagIndexes)+len(edgeIndexes) == 0 {
+		return script.String(), nil
+	}
+	script.sleep(scriptOpts.sleepSeconds)
+	for _, index := range tagIndexes {
+		if err := script.writeStatement(New().CreateVertexTagsIndex(index, true)); err != nil {
+			return "", err
+		}
+	}
+	for _, index := range edgeIndexes {
+		if err := script.writeStatement(New().CreateEdgeIndex(index, true)); err != nil {
+			return "", err
+		}
+	}
+	script.sleep(scriptOpts.sleepSeconds)
+	if len(tagIndexes) > 0 {
+		if err := script.writeStatement(New().RebuildVertexTagIndexes(indexNamesOf(tagIndexes)...)); err != nil {
+			return "", err
+		}
+	}
+	if len(edgeIndexes) > 0 {
+		if err := script.writeStatement(New().RebuildEdgeIndexes(indexNamesOf(edgeIndexes)...)); err != nil {
+			return "", err
+		}
+	}
+	return script.String(), nil
+}
+
+type scriptBuilder struct {
+	strings.Builder
+}
+
+func (b *scriptBuilder) writeStatement(stmt *Statement) error {
+	nGQL, err := stmt.NGQL()
+	if err != nil {
+		return err
+	}
+	b.writeLine(strings.TrimSuffix(nGQL, ";"))
+	return nil
+}
+
+func (b *scriptBuilder) writeLine(nGQL string) {
+	b.WriteString(nGQL)
+	b.WriteString(";\n")
+}
+
+func (b *scriptBuilder) sleep(seconds int) {
+	if seconds > 0 {
+		b.WriteString(":sleep " + strconv.Itoa(seconds) + "\n")
+	}
+}
+
+func indexNamesOf(indexes []*resolver.Index) []string {
+	names := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		names = append(names, index.Name)
+	}
+	return names
+}
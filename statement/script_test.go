@@ -0,0 +1,50 @@
+package statement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDDLScript(t *testing.T) {
+	script, err := DDLScript([]any{vm1{}, &vm1{}, vm2{}, em1{}}, WithScriptSpace("test"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `USE test;
+CREATE TAG IF NOT EXISTS player(name string, age int);
+CREATE TAG IF NOT EXISTS no_property();
+CREATE EDGE IF NOT EXISTS follow(degree int);
+:sleep 20
+CREATE TAG INDEX IF NOT EXISTS idx_player_name ON player(name(5));
+CREATE TAG INDEX IF NOT EXISTS idx_player_age ON player(age);
+CREATE EDGE INDEX IF NOT EXISTS idx_follow_degree ON follow(degree);
+:sleep 20
+REBUILD TAG INDEX idx_player_name, idx_player_age;
+REBUILD EDGE INDEX idx_follow_degree;
+`, script)
+	}
+
+	script, err = DDLScript([]any{vm1{}, em1{}}, WithScriptSleep(0))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `CREATE TAG IF NOT EXISTS player(name string, age int);
+CREATE EDGE IF NOT EXISTS follow(degree int);
+CREATE TAG INDEX IF NOT EXISTS idx_player_name ON player(name(5));
+CREATE TAG INDEX IF NOT EXISTS idx_player_age ON player(age);
+CREATE EDGE INDEX IF NOT EXISTS idx_follow_degree ON follow(degree);
+REBUILD TAG INDEX idx_player_name, idx_player_age;
+REBUILD EDGE INDEX idx_follow_degree;
+`, script)
+	}
+
+	script, err = DDLScript([]any{vm1{}}, WithScriptSleep(30))
+	if assert.NoError(t, err) {
+		assert.Contains(t, script, ":sleep 30\nCREATE TAG INDEX")
+	}
+
+	script, err = DDLScript([]any{vm2{}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "CREATE TAG IF NOT EXISTS no_property();\n", script)
+	}
+
+	_, err = DDLScript([]any{nil})
+	assert.Error(t, err)
+}
@@ -0,0 +1,475 @@
+package statement
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/haysons/norm/clause"
+	"github.com/haysons/norm/resolver"
+)
+
+// SoftDeletes maps the names of the tags and edges to their props declared with the soft_delete setting, it is used
+// to filter out the soft deleted data in the queries that only refer to the tags and edges by name
+type SoftDeletes map[string]*resolver.Prop
+
+// ParseSoftDeletes parses the soft delete props of the given vertices and edges, the structs that implement
+// resolver.EdgeTypeNamer are treated as edges, the others as vertices. The tags and edges without a soft delete prop
+// are skipped.
+func ParseSoftDeletes(models ...any) (SoftDeletes, error) {
+	softDeletes := make(SoftDeletes)
+	for _, model := range models {
+		modelType := reflect.TypeOf(model)
+		if modelType == nil {
+			return nil, fmt.Errorf("norm: %w, parse soft delete failed, model is nil", clause.ErrInvalidClauseParams)
+		}
+		if modelType.Kind() == reflect.Ptr {
+			modelType = modelType.Elem()
+		}
+		if _, ok := reflect.New(modelType).Interface().(resolver.EdgeTypeNamer); ok {
+			edge, err := resolver.ParseEdge(modelType)
+			if err != nil {
+				return nil, err
+			}
+			if prop := edge.GetSoftDeleteProp(); prop != nil {
+				softDeletes[edge.GetTypeName()] = prop
+			}
+			continue
+		}
+		vertex, err := resolver.ParseVertex(modelType)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range vertex.GetTags() {
+			if prop := tag.GetSoftDeleteProp(); prop != nil {
+				softDeletes[tag.TagName] = prop
+			}
+		}
+	}
+	return softDeletes, nil
+}
+
+// SoftDeletes sets the soft delete props of the tags and edges, the LOOKUP, GO and FETCH statements on them only
+// return the data not soft deleted, and DeleteEdge on them marks the edges instead of deleting them
+//
+// LOOKUP ON player WHERE player.deleted_at IS NULL YIELD id(vertex)
+// stmt.SoftDeletes(softDeletes).Lookup("player").Yield("id(vertex)")
+//
+// GO FROM "player100" OVER follow WHERE follow.deleted_at IS NULL YIELD dst(edge)
+// stmt.SoftDeletes(softDeletes).Go().From("player100").Over("follow").Yield("dst(edge)")
+//
+// FETCH PROP ON player "player100" YIELD player.name AS name, player.deleted_at AS __deleted_1 | YIELD $-.name AS name WHERE $-.__deleted_1 IS NULL
+// stmt.SoftDeletes(softDeletes).Fetch("player", "player100").Yield("player.name AS name")
+func (stmt *Statement) SoftDeletes(softDeletes SoftDeletes) *Statement {
+	stmt.softDeletes = softDeletes
+	return stmt
+}
+
+// Unscoped disables the filter of the soft deleted data, so that the queries return the soft deleted data as well
+func (stmt *Statement) Unscoped() *Statement {
+	stmt.unscoped = true
+	return stmt
+}
+
+// HardDelete makes DeleteVertex and DeleteEdge delete the data even if they are declared with a soft delete prop
+func (stmt *Statement) HardDelete() *Statement {
+	stmt.hardDelete = true
+	return stmt
+}
+
+// VertexModel sets the struct of the vertices deleted by DeleteVertex, so that the vertices given as vids are soft
+// deleted by the soft delete props of its tags, it is set by the generic API whose vertex type is known
+//
+// UPDATE VERTEX ON player "player100" SET deleted_at = datetime()
+// stmt.VertexModel(Player{}).DeleteVertex("player100")
+func (stmt *Statement) VertexModel(vertex any) *Statement {
+	stmt.vertexModel = vertex
+	return stmt
+}
+
+// scopedParts returns the parts to be built, the parts on the soft deleted tags and edges are replaced by the copies
+// filtering out or marking the soft deleted data, while the parts of the statement itself are left unchanged
+func (stmt *Statement) scopedParts() ([]*Part, error) {
+	parts := make([]*Part, 0, len(stmt.parts))
+	for _, part := range stmt.parts {
+		var (
+			scoped []*Part
+			err    error
+		)
+		switch part.typ {
+		case PartTypeLookup, PartTypeGo:
+			if !stmt.unscoped {
+				scoped = stmt.filterPart(part)
+			}
+		case PartTypeFetch:
+			if !stmt.unscoped {
+				scoped, err = stmt.filterFetchPart(part)
+			}
+		case PartTypeDeleteVertex:
+			scoped, err = stmt.softDeleteVertexPart(part)
+		case PartTypeDeleteEdge:
+			scoped, err = stmt.softDeleteEdgePart(part)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if scoped == nil {
+			scoped = []*Part{part}
+		}
+		parts = append(parts, scoped...)
+	}
+	return parts, nil
+}
+
+// filterPart adds the filter of the soft deleted data to the WHERE clause of the LOOKUP or GO part
+func (stmt *Statement) filterPart(part *Part) []*Part {
+	names := make([]string, 0)
+	if c, ok := part.clauses[clause.LookupName]; ok {
+		if lookup, ok := c.Expression.(clause.Lookup); ok {
+			names = append(names, lookup.TypeName)
+		}
+	}
+	if c, ok := part.clauses[clause.OverName]; ok {
+		if over, ok := c.Expression.(clause.Over); ok {
+			names = append(names, over.EdgeTypeList...)
+		}
+	}
+	conditions := make([]clause.Condition, 0)
+	for _, name := range names {
+		if prop, ok := stmt.softDeletes[name]; ok {
+			conditions = append(conditions, clause.Condition{
+				Operator: clause.OperatorAnd,
+				Expr:     clause.Expr{Str: name + "." + prop.Name + " IS NULL"},
+			})
+		}
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	scoped := part.clone()
+	if c, ok := scoped.clauses[clause.WhereName]; ok {
+		if exist, ok := c.Expression.(clause.Where); ok && len(exist.Conditions) > 0 {
+			conditions = append([]clause.Condition{{Operator: clause.OperatorAnd, Group: exist.Conditions}}, conditions...)
+		}
+	}
+	scoped.clauses[clause.WhereName] = clause.Clause{Name: clause.WhereName, Expression: clause.Where{Conditions: conditions}}
+	return []*Part{scoped}
+}
+
+// filterFetchPart filters the soft deleted data of the FETCH part, since FETCH has no WHERE clause, the soft delete
+// props are yielded along with the columns and filtered by a piped YIELD ... WHERE, which requires the columns to be
+// aliased so that they can be yielded again
+func (stmt *Statement) filterFetchPart(part *Part) ([]*Part, error) {
+	c, ok := part.clauses[clause.FetchName]
+	if !ok {
+		return nil, nil
+	}
+	fetch, ok := c.Expression.(clause.Fetch)
+	if !ok {
+		return nil, nil
+	}
+	deletedCols := make([]string, 0)
+	for _, name := range fetch.Names {
+		if prop, ok := stmt.softDeletes[name]; ok {
+			deletedCols = append(deletedCols, name+"."+prop.Name)
+		}
+	}
+	if len(deletedCols) == 0 {
+		return nil, nil
+	}
+	yield, ok := part.clauses[clause.YieldName].Expression.(clause.Yield)
+	if !ok {
+		return nil, fmt.Errorf("norm: %w, FETCH on the soft deleted %s must have a yield clause", clause.ErrInvalidClauseParams, strings.Join(fetch.Names, ", "))
+	}
+	pipeExprList := make([]string, 0)
+	for _, expr := range yield.ExprList {
+		for _, item := range splitExprList(expr) {
+			alias := exprAlias(item)
+			if alias == "" {
+				return nil, fmt.Errorf("norm: %w, the yield columns of FETCH on the soft deleted %s must be aliased, or use Unscoped", clause.ErrInvalidClauseParams, strings.Join(fetch.Names, ", "))
+			}
+			pipeExprList = append(pipeExprList, "$-."+alias+" AS "+alias)
+		}
+	}
+	fetchExprList := append([]string(nil), yield.ExprList...)
+	conditions := make([]clause.Condition, 0, len(deletedCols))
+	for i, col := range deletedCols {
+		alias := "__deleted_" + strconv.Itoa(i+1)
+		fetchExprList = append(fetchExprList, col+" AS "+alias)
+		conditions = append(conditions, clause.Condition{Operator: clause.OperatorAnd, Expr: clause.Expr{Str: "$-." + alias + " IS NULL"}})
+	}
+	scoped := part.clone()
+	scoped.clauses[clause.YieldName] = clause.Clause{
+		Name:       clause.YieldName,
+		Expression: clause.Yield{Distinct: yield.Distinct, ExprList: fetchExprList},
+	}
+	filter := NewPart()
+	filter.SetCompType(CompositeTypePipe)
+	filter.AddClause(&clause.Yield{ExprList: pipeExprList})
+	filter.AddClause(&clause.Where{Conditions: conditions})
+	filter.SetClausesBuild([]string{clause.YieldName, clause.WhereName})
+	return []*Part{scoped, filter}, nil
+}
+
+// softDeleteVertexPart replaces DELETE VERTEX by UPDATE VERTEX setting the soft delete props, when the vertices are
+// given as structs whose tags declare soft delete props, or as vids along with such a struct set by VertexModel. The
+// tags of the vertices given as vids are otherwise unknown, so that they are deleted as before. WITH EDGE is rejected
+// when the vertices are soft deleted, as the edge types of the vertices are unknown as well.
+func (stmt *Statement) softDeleteVertexPart(part *Part) ([]*Part, error) {
+	c, ok := part.clauses[clause.DeleteVertexName]
+	if !ok {
+		return nil, nil
+	}
+	deleteVertex, ok := c.Expression.(clause.DeleteVertex)
+	if !ok {
+		return nil, nil
+	}
+	vertexValues, vertexType := structValues(deleteVertex.VID)
+	byVID := len(vertexValues) == 0 || !isVertex(vertexType)
+	if byVID {
+		if stmt.hardDelete || stmt.vertexModel == nil {
+			return nil, nil
+		}
+		vertexType = reflect.TypeOf(stmt.vertexModel)
+		if vertexType.Kind() == reflect.Ptr {
+			vertexType = vertexType.Elem()
+		}
+		if vertexType.Kind() != reflect.Struct || !isVertex(vertexType) {
+			return nil, nil
+		}
+	}
+	vertex, err := resolver.ParseVertex(vertexType)
+	if err != nil {
+		return nil, err
+	}
+	vids := make([]any, 0, len(vertexValues))
+	if byVID {
+		vids = splitVIDs(deleteVertex.VID)
+	} else {
+		// the structs cannot be written into the statement, they are replaced by their vids
+		exprs := make([]clause.Expr, 0, len(vertexValues))
+		for _, vertexValue := range vertexValues {
+			expr := clause.Expr{Str: vertex.GetVIDExpr(vertexValue)}
+			exprs = append(exprs, expr)
+			vids = append(vids, expr)
+		}
+		deleteVertex.VID = exprs
+	}
+	softTags := make([]*resolver.VertexTag, 0)
+	for _, tag := range vertex.GetTags() {
+		if tag.GetSoftDeleteProp() != nil {
+			softTags = append(softTags, tag)
+		}
+	}
+	if stmt.hardDelete || len(softTags) == 0 || len(vids) == 0 {
+		if byVID {
+			return nil, nil
+		}
+		scoped := part.clone()
+		scoped.clauses[clause.DeleteVertexName] = clause.Clause{Name: clause.DeleteVertexName, Expression: deleteVertex}
+		return []*Part{scoped}, nil
+	}
+	if deleteVertex.WithEdge {
+		return nil, fmt.Errorf("norm: %w, the edges of the soft deleted vertices cannot be marked, delete the edges by DeleteEdge or use HardDelete", clause.ErrInvalidClauseParams)
+	}
+	parts := make([]*Part, 0, len(vids)*len(softTags))
+	for _, vid := range vids {
+		for _, tag := range softTags {
+			prop := tag.GetSoftDeleteProp()
+			value, err := softDeleteValue(prop)
+			if err != nil {
+				return nil, err
+			}
+			updatePart := NewPart()
+			updatePart.AddClause(&clause.UpdateVertex{
+				VID:       vid,
+				TagUpdate: map[string]any{prop.Name: value},
+				Opts:      clause.Options{TagName: tag.TagName},
+			})
+			updatePart.SetType(PartTypeUpdateVertex)
+			parts = append(parts, updatePart)
+		}
+	}
+	parts[0].SetCompType(part.compType)
+	return parts, nil
+}
+
+// softDeleteEdgePart replaces DELETE EDGE by UPDATE EDGE setting the soft delete prop, when the edge type is set by
+// SoftDeletes, or the edges are given as structs declaring a soft delete prop
+func (stmt *Statement) softDeleteEdgePart(part *Part) ([]*Part, error) {
+	if stmt.hardDelete {
+		return nil, nil
+	}
+	c, ok := part.clauses[clause.DeleteEdgeName]
+	if !ok {
+		return nil, nil
+	}
+	deleteEdge, ok := c.Expression.(clause.DeleteEdge)
+	if !ok {
+		return nil, nil
+	}
+	prop := stmt.softDeletes[deleteEdge.EdgeTypeName]
+	edges := make([]any, 0)
+	switch edge := deleteEdge.Edges.(type) {
+	case string:
+		edges = append(edges, deleteEdge.EdgeTypeName+" "+edge)
+	case []string:
+		for _, e := range edge {
+			edges = append(edges, deleteEdge.EdgeTypeName+" "+e)
+		}
+	default:
+		edgeValues, edgeType := structValues(edge)
+		if len(edgeValues) == 0 {
+			return nil, nil
+		}
+		edgeSchema, err := resolver.ParseEdge(edgeType)
+		if err != nil {
+			return nil, err
+		}
+		if prop == nil {
+			prop = edgeSchema.GetSoftDeleteProp()
+		}
+		for _, edgeValue := range edgeValues {
+			edges = append(edges, edgeValue.Interface())
+		}
+	}
+	if prop == nil || len(edges) == 0 {
+		return nil, nil
+	}
+	value, err := softDeleteValue(prop)
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]*Part, 0, len(edges))
+	for _, edge := range edges {
+		updatePart := NewPart()
+		updatePart.AddClause(&clause.UpdateEdge{
+			Edge:        edge,
+			PropsUpdate: map[string]any{prop.Name: value},
+		})
+		updatePart.SetType(PartTypeUpdateEdge)
+		parts = append(parts, updatePart)
+	}
+	parts[0].SetCompType(part.compType)
+	return parts, nil
+}
+
+// softDeleteValue returns the function generating the current time of the data type of the soft delete prop
+func softDeleteValue(prop *resolver.Prop) (clause.Expr, error) {
+	dataType := strings.ToLower(prop.DataType)
+	// the data type of a pointer field is derived from the type it points to
+	if propType := prop.Type; dataType == "" && propType.Kind() == reflect.Ptr {
+		dataType = resolver.GetFieldDataType(reflect.StructField{Type: propType.Elem()})
+	}
+	switch dataType {
+	case "datetime", "date":
+		return clause.Expr{Str: dataType + "()"}, nil
+	case "timestamp", "int", "int64":
+		return clause.Expr{Str: "timestamp()"}, nil
+	}
+	return clause.Expr{}, fmt.Errorf("norm: %w, soft delete prop %s must be a datetime, date, timestamp or int64, got %s", clause.ErrInvalidClauseParams, prop.Name, prop.DataType)
+}
+
+// splitVIDs returns each of the vids given to DeleteVertex, such as a string, an int64 or a slice of them
+func splitVIDs(vid any) []any {
+	value := reflect.ValueOf(vid)
+	if !value.IsValid() {
+		return nil
+	}
+	if value.Kind() != reflect.Slice {
+		return []any{vid}
+	}
+	vids := make([]any, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		vids = append(vids, value.Index(i).Interface())
+	}
+	return vids
+}
+
+func isVertex(t reflect.Type) bool {
+	switch reflect.New(t).Interface().(type) {
+	case resolver.VertexIDStr, resolver.VertexIDInt64:
+		return true
+	}
+	return false
+}
+
+// structValues returns the values of a struct, a struct pointer or a slice of them, along with the struct type
+func structValues(v any) ([]reflect.Value, reflect.Type) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if !value.IsValid() {
+		return nil, nil
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		return []reflect.Value{value}, value.Type()
+	case reflect.Slice, reflect.Array:
+		elemType := value.Type().Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() != reflect.Struct {
+			return nil, nil
+		}
+		values := make([]reflect.Value, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			if elem := reflect.Indirect(value.Index(i)); elem.IsValid() {
+				values = append(values, elem)
+			}
+		}
+		return values, elemType
+	}
+	return nil, nil
+}
+
+// splitExprList splits the comma separated expressions, the commas in brackets and quotes are kept
+func splitExprList(exprList string) []string {
+	items := make([]string, 0)
+	var (
+		depth int
+		quote rune
+		start int
+	)
+	for i, r := range exprList {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			depth--
+		case r == ',' && depth == 0:
+			items = append(items, strings.TrimSpace(exprList[start:i]))
+			start = i + 1
+		}
+	}
+	return append(items, strings.TrimSpace(exprList[start:]))
+}
+
+var aliasPattern = regexp.MustCompile(`(?i)\sAS\s+([A-Za-z_][A-Za-z0-9_]*|` + "`[^`]+`" + `)$`)
+
+// exprAlias returns the alias of the yielded expression, an empty string is returned if it is not aliased
+func exprAlias(expr string) string {
+	matches := aliasPattern.FindStringSubmatch(strings.TrimSpace(expr))
+	if matches == nil {
+		return ""
+	}
+	return matches[1]
+}
+
+func (p *Part) clone() *Part {
+	return &Part{
+		typ:          p.typ,
+		setType:      p.setType,
+		compType:     p.compType,
+		clauses:      maps.Clone(p.clauses),
+		clausesBuild: p.clausesBuild,
+	}
+}
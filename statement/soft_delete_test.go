@@ -0,0 +1,224 @@
+package statement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type softPlayer struct {
+	VID       string     `norm:"vertex_id"`
+	Name      string     `norm:"prop:name"`
+	DeletedAt *time.Time `norm:"prop:deleted_at;soft_delete"`
+}
+
+func (p softPlayer) VertexID() string {
+	return p.VID
+}
+
+func (p softPlayer) VertexTagName() string {
+	return "player"
+}
+
+type softFollow struct {
+	SrcID     string `norm:"edge_src_id"`
+	DstID     string `norm:"edge_dst_id"`
+	Rank      int    `norm:"edge_rank"`
+	Degree    int    `norm:"prop:degree"`
+	DeletedAt int64  `norm:"prop:deleted_at;type:timestamp;soft_delete"`
+}
+
+func (f softFollow) EdgeTypeName() string {
+	return "follow"
+}
+
+type softTeam struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+}
+
+func (t softTeam) VertexID() string {
+	return t.VID
+}
+
+func (t softTeam) VertexTagName() string {
+	return "team"
+}
+
+func TestSoftDelete(t *testing.T) {
+	softDeletes, err := ParseSoftDeletes(softPlayer{}, &softFollow{}, softTeam{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, softDeletes, 2)
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Lookup("player").Yield("id(vertex)")
+			},
+			want: `LOOKUP ON player WHERE player.deleted_at IS NULL YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Lookup("player").Where("player.name == ?", "Tim").Or("player.name == ?", "Tony").Yield("id(vertex)")
+			},
+			want: `LOOKUP ON player WHERE (player.name == "Tim" OR player.name == "Tony") AND player.deleted_at IS NULL YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Unscoped().Lookup("player").Yield("id(vertex)")
+			},
+			want: `LOOKUP ON player YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Lookup("team").Yield("id(vertex)")
+			},
+			want: `LOOKUP ON team YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Go().From("player100").Over("follow", "serve").Yield("dst(edge)")
+			},
+			want: `GO FROM "player100" OVER follow, serve WHERE follow.deleted_at IS NULL YIELD dst(edge);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Fetch("player", "player100").Yield("player.name AS name, id(vertex) AS vid").OrderBy("$-.name")
+			},
+			want: `FETCH PROP ON player "player100" YIELD player.name AS name, id(vertex) AS vid, player.deleted_at AS __deleted_1 | YIELD $-.name AS name, $-.vid AS vid WHERE $-.__deleted_1 IS NULL | ORDER BY $-.name;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).Fetch("player", "player100").Yield("properties(vertex)")
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteVertex(softPlayer{VID: "player100"})
+			},
+			want: `UPDATE VERTEX ON player "player100" SET deleted_at = datetime();`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteVertex([]*softPlayer{{VID: "player100"}, {VID: "player101"}})
+			},
+			want: `UPDATE VERTEX ON player "player100" SET deleted_at = datetime(); UPDATE VERTEX ON player "player101" SET deleted_at = datetime();`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().HardDelete().DeleteVertex([]softPlayer{{VID: "player100"}, {VID: "player101"}}, true)
+			},
+			want: `DELETE VERTEX "player100", "player101" WITH EDGE;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteVertex(softTeam{VID: "team200"})
+			},
+			want: `DELETE VERTEX "team200";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).DeleteVertex("player100")
+			},
+			want: `DELETE VERTEX "player100";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().VertexModel(softPlayer{}).DeleteVertex([]string{"player100", "player101"})
+			},
+			want: `UPDATE VERTEX ON player "player100" SET deleted_at = datetime(); UPDATE VERTEX ON player "player101" SET deleted_at = datetime();`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().VertexModel(&softPlayer{}).HardDelete().DeleteVertex("player100")
+			},
+			want: `DELETE VERTEX "player100";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().VertexModel(softPlayer{}).DeleteVertex("player100", true)
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().VertexModel(softTeam{}).DeleteVertex("team200", true)
+			},
+			want: `DELETE VERTEX "team200" WITH EDGE;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).HardDelete().DeleteVertex("player100")
+			},
+			want: `DELETE VERTEX "player100";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteVertex("player100")
+			},
+			want: `DELETE VERTEX "player100";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteVertex(softPlayer{VID: "player100"}, true)
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().HardDelete().DeleteVertex(softPlayer{VID: "player100"}, true)
+			},
+			want: `DELETE VERTEX "player100" WITH EDGE;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).DeleteVertex(softTeam{VID: "team200"}, true)
+			},
+			want: `DELETE VERTEX "team200" WITH EDGE;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).DeleteEdge("follow", `"player100"->"player101"@1`)
+			},
+			want: `UPDATE EDGE ON follow "player100"->"player101"@1 SET deleted_at = timestamp();`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteEdge("follow", []softFollow{{SrcID: "player100", DstID: "player101"}, {SrcID: "player100", DstID: "player102", Rank: 1}})
+			},
+			want: `UPDATE EDGE ON follow "player100"->"player101" SET deleted_at = timestamp(); UPDATE EDGE ON follow "player100"->"player102"@1 SET deleted_at = timestamp();`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().SoftDeletes(softDeletes).HardDelete().DeleteEdge("follow", `"player100"->"player101"`)
+			},
+			want: `DELETE EDGE follow "player100"->"player101";`,
+		},
+	}
+	for i, tt := range tests {
+		got, err := tt.stmt().NGQL()
+		if tt.wantErr {
+			assert.Error(t, err, "case %d", i)
+			continue
+		}
+		if assert.NoError(t, err, "case %d", i) {
+			assert.Equal(t, tt.want, got, "case %d", i)
+		}
+	}
+}
+
+func TestExprAlias(t *testing.T) {
+	assert.Equal(t, []string{"player.name AS name", "count(*) as c", `split("a,b", ",") AS parts`},
+		splitExprList(`player.name AS name, count(*) as c, split("a,b", ",") AS parts`))
+	assert.Equal(t, "name", exprAlias("player.name AS name"))
+	assert.Equal(t, "c", exprAlias("count(*) as c"))
+	assert.Equal(t, "`my col`", exprAlias("player.age AS `my col`"))
+	assert.Equal(t, "", exprAlias("properties(vertex)"))
+}
@@ -1,6 +1,7 @@
 package statement
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/haysons/norm/clause"
@@ -10,10 +11,17 @@ import (
 // A statement consists of multiple parts, which may be separated by '|', and each part consists of multiple clauses
 // that independently construct their own part of the statement. The statement object is not concurrency safe.
 type Statement struct {
-	parts []*Part
-	nGQL  *strings.Builder
-	built bool
-	err   error
+	parts         []*Part
+	raw           *clause.Expr
+	nGQL          *strings.Builder
+	parameterized bool
+	params        map[string]any
+	built         bool
+	err           error
+	softDeletes   SoftDeletes
+	unscoped      bool
+	hardDelete    bool
+	vertexModel   any
 }
 
 func New() *Statement {
@@ -31,6 +39,11 @@ func (stmt *Statement) LastPart() *Part {
 	return stmt.parts[len(stmt.parts)-1]
 }
 
+// Parts returns all parts of the current statement
+func (stmt *Statement) Parts() []*Part {
+	return stmt.parts
+}
+
 // AddPart add a new part at the end of the current statement
 func (stmt *Statement) AddPart(part *Part) {
 	stmt.parts = append(stmt.parts, part)
@@ -64,16 +77,50 @@ func (stmt *Statement) SetClausesBuild(clauses []string) {
 	part.SetClausesBuild(clauses)
 }
 
+// Parameterized switches the statement to parameter mode, in which the arguments of Where, When and Raw are not
+// written into the statement as literals, but replaced by parameter references such as $p1, the values of which can
+// be obtained through Params and sent to the server along with the statement.
+//
+// WHERE player.name == $p1, params: {"p1": "Tim Duncan"}
+// stmt.Parameterized().Where("player.name == ?", "Tim Duncan")
+func (stmt *Statement) Parameterized() *Statement {
+	stmt.parameterized = true
+	return stmt
+}
+
+// IsParameterized reports whether the statement is in parameter mode
+func (stmt *Statement) IsParameterized() bool {
+	return stmt.parameterized
+}
+
 // Build the current statement; any problems during build will return erring
 func (stmt *Statement) Build() error {
 	if stmt.err != nil || stmt.built {
 		return stmt.err
 	}
 	stmt.nGQL.Reset()
-	stmt.nGQL.Grow(100 * len(stmt.parts))
+	stmt.params = nil
+	var builder clause.Builder = stmt.nGQL
+	if stmt.parameterized {
+		stmt.params = make(map[string]any)
+		builder = &paramBuilder{Builder: stmt.nGQL, params: stmt.params}
+	}
+	// the raw statement is written as it is, the clauses added afterward are ignored
+	if stmt.raw != nil {
+		stmt.err = stmt.raw.Build(builder)
+		stmt.built = true
+		return stmt.err
+	}
+	parts, err := stmt.scopedParts()
+	if err != nil {
+		stmt.err = err
+		stmt.built = true
+		return err
+	}
+	stmt.nGQL.Grow(100 * len(parts))
 	var firstPartBuilt bool
 	// generate statements for each part in turn
-	for _, part := range stmt.parts {
+	for _, part := range parts {
 		if len(part.clauses) == 0 {
 			continue
 		}
@@ -84,10 +131,12 @@ func (stmt *Statement) Build() error {
 				stmt.nGQL.WriteString(" | ")
 			case CompositeTypeMulti:
 				stmt.nGQL.WriteString("; ")
+			case CompositeTypeChain:
+				stmt.nGQL.WriteByte(' ')
 			}
 		}
 		firstPartBuilt = true
-		if err := part.Build(stmt.nGQL); err != nil {
+		if err := part.Build(builder); err != nil {
 			stmt.err = err
 			break
 		}
@@ -105,6 +154,28 @@ func (stmt *Statement) NGQL() (string, error) {
 	return stmt.nGQL.String(), nil
 }
 
+// Params build the statement and return the parameters referenced by it, the map is empty unless the statement is
+// in parameter mode
+func (stmt *Statement) Params() (map[string]any, error) {
+	if err := stmt.Build(); err != nil {
+		return nil, err
+	}
+	return stmt.params, nil
+}
+
+// paramBuilder writes the statement and records the parameters referenced in it, the parameters are named
+// p1, p2... in the order they appear
+type paramBuilder struct {
+	*strings.Builder
+	params map[string]any
+}
+
+func (b *paramBuilder) AddParam(value any) string {
+	name := "p" + strconv.Itoa(len(b.params)+1)
+	b.params[name] = value
+	return "$" + name
+}
+
 // Part is the part of the statement that actually contains the clause to be constructed and completes the construction
 // of the statement by calling the clause's Build method. Because the concept of a compound statement exists in nGQL,
 // it is necessary to add another layer to the statement concept to generate each part of the compound statement
@@ -156,6 +227,18 @@ func (p *Part) SetClausesBuild(clauses []string) {
 	p.clausesBuild = clauses
 }
 
+// HasClause reports whether a clause with the given name has been added to the current part.
+func (p *Part) HasClause(name string) bool {
+	_, ok := p.clauses[name]
+	return ok
+}
+
+// GetClause returns the clause with the given name that has been added to the current part.
+func (p *Part) GetClause(name string) (clause.Clause, bool) {
+	c, ok := p.clauses[name]
+	return c, ok
+}
+
 func (p *Part) AddClause(v clause.Interface) {
 	name := v.Name()
 	c := p.clauses[name]
@@ -185,6 +268,7 @@ type CompositeType int
 const (
 	CompositeTypePipe CompositeType = iota + 1
 	CompositeTypeMulti
+	CompositeTypeChain // parts are joined by a space, used to chain openCypher clauses such as MATCH ... WITH ... RETURN
 )
 
 type PartType int
@@ -212,6 +296,10 @@ const (
 	PartTypeRebuildIndex
 	PartTypeDropIndex
 	PartTypeGetSubgraph
+	PartTypeMatch
+	PartTypeFindPath
+	PartTypeCreateSpace
+	PartTypeDropSpace
 )
 
 func (p *Part) getClausesBuild() []string {
@@ -263,6 +351,14 @@ func (p *Part) getClausesBuild() []string {
 		return []string{clause.DropIndexName}
 	case PartTypeGetSubgraph:
 		return []string{clause.GetSubgraphName, clause.FromName, clause.InName, clause.OutName, clause.BothName, clause.WhereName, clause.YieldName}
+	case PartTypeMatch:
+		return []string{clause.MatchName, clause.OptionalMatchName, clause.UnwindName, clause.WithName, clause.ReturnName, clause.WhereName, clause.OrderName, clause.SkipName, clause.LimitName}
+	case PartTypeFindPath:
+		return []string{clause.FindPathName, clause.FromName, clause.ToName, clause.OverName, clause.WhereName, clause.UptoName, clause.YieldName}
+	case PartTypeCreateSpace:
+		return []string{clause.CreateSpaceName}
+	case PartTypeDropSpace:
+		return []string{clause.DropSpaceName}
 	default:
 		// The following clauses may not belong to a specific type of statement and can be used separately
 		return []string{clause.GroupName, clause.YieldName, clause.OrderName, clause.LimitName}
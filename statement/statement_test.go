@@ -58,3 +58,78 @@ func TestStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestParameterized(t *testing.T) {
+	tests := []struct {
+		stmt       func() *Statement
+		want       string
+		wantParams map[string]any
+		wantErr    bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().Parameterized().Lookup("player").Where("player.name == ?", "Tim Duncan").Yield("id(vertex)")
+			},
+			want:       `LOOKUP ON player WHERE player.name == $p1 YIELD id(vertex);`,
+			wantParams: map[string]any{"p1": "Tim Duncan"},
+		},
+		{
+			stmt: func() *Statement {
+				return New().Parameterized().Go().From("player100").Over("follow").
+					Where("properties(edge).degree > ?", int32(90)).
+					Or("properties($$).age IN ?", []int{33, 34}).
+					Yield("dst(edge)")
+			},
+			want:       `GO FROM "player100" OVER follow WHERE properties(edge).degree > $p1 OR properties($$).age IN $p2 YIELD dst(edge);`,
+			wantParams: map[string]any{"p1": int64(90), "p2": []any{int64(33), int64(34)}},
+		},
+		{
+			stmt: func() *Statement {
+				return New().Parameterized().UpdateVertex("player100", map[string]any{"age": 42}, clause.WithTagName("player")).
+					When("player.name == ? AND player.age > ?", "Tim Duncan", clause.Expr{Str: "?", Vars: []any{40}})
+			},
+			want:       `UPDATE VERTEX ON player "player100" SET age = 42 WHEN (player.name == $p1 AND player.age > $p2);`,
+			wantParams: map[string]any{"p1": "Tim Duncan", "p2": int64(40)},
+		},
+		{
+			stmt: func() *Statement {
+				return New().Parameterized().Raw("LOOKUP ON player WHERE player.age > ? YIELD id(vertex)", 40.5)
+			},
+			want:       `LOOKUP ON player WHERE player.age > $p1 YIELD id(vertex)`,
+			wantParams: map[string]any{"p1": 40.5},
+		},
+		{
+			stmt: func() *Statement {
+				return New().Parameterized().Match("(v:player)").Where("v.player.age > ?", 40).Return("v")
+			},
+			want:       `MATCH (v:player) WHERE v.player.age > $p1 RETURN v;`,
+			wantParams: map[string]any{"p1": int64(40)},
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.name == ?", "Tim Duncan").Yield("id(vertex)")
+			},
+			want: `LOOKUP ON player WHERE player.name == "Tim Duncan" YIELD id(vertex);`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			s := tt.stmt()
+			ngql, err := s.NGQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, ngql)
+			}
+			params, err := s.Params()
+			if assert.NoError(t, err) {
+				assert.Equal(t, len(tt.wantParams), len(params))
+				for k, v := range tt.wantParams {
+					assert.Equal(t, v, params[k])
+				}
+			}
+		})
+	}
+}